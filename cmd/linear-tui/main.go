@@ -1,9 +1,13 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/cli"
 	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 	"github.com/roeyazroel/linear-tui/internal/logger"
@@ -17,6 +21,25 @@ func main() {
 		os.Exit(0)
 	}
 
+	// --profile NAME selects an account profile from the settings file
+	profileName, args, err := config.ExtractProfileFlag(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Headless subcommands (issues list, issue show, ...) skip the TUI entirely
+	headless := len(args) > 0 && cli.IsCommand(args[0])
+	if headless && (args[0] == "help" || args[0] == "webhook") {
+		os.Exit(cli.Run(context.Background(), nil, args, os.Stdin, os.Stdout, os.Stderr))
+	}
+	if headless && args[0] == "login" {
+		if profileName != "" {
+			args = append(args, "--profile", profileName)
+		}
+		os.Exit(cli.Run(context.Background(), nil, args, os.Stdin, os.Stdout, os.Stderr))
+	}
+
 	// Load configuration from settings file + API key
 	settingsPath, err := config.ConfigFilePath()
 	if err != nil {
@@ -30,12 +53,12 @@ func main() {
 		os.Exit(1)
 	}
 
-	apiKey := os.Getenv(config.LinearAPIKeyEnv)
-	cfg, err := config.ConfigFromSettings(apiKey, settings)
+	cfg, err := config.ConfigForProfile(settings, profileName)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
-		if apiKey == "" {
-			fmt.Fprintf(os.Stderr, "Please set the %s environment variable.\n", config.LinearAPIKeyEnv)
+		var missingKey *config.MissingAPIKeyError
+		if errors.As(err, &missingKey) {
+			fmt.Fprintf(os.Stderr, "Please set the %s environment variable, or api_key_command or api_key_file in %s.\n", missingKey.Env, settingsPath)
 		}
 		os.Exit(1)
 	}
@@ -53,16 +76,27 @@ func main() {
 	}()
 
 	logger.Info("app.main: application starting")
-	logger.Debug("app.main: configuration endpoint=%s page_size=%d cache_ttl=%s",
-		cfg.APIEndpoint, cfg.PageSize, cfg.CacheTTL)
+	logger.Debug("app.main: configuration profile=%s endpoint=%s page_size=%d cache_ttl=%s",
+		cfg.Profile, cfg.Endpoint(), cfg.PageSize, cfg.CacheTTL)
 
 	// Create Linear API client with full configuration
 	apiClient := linearapi.NewClient(linearapi.ClientConfig{
-		Token:    cfg.LinearAPIKey,
-		Endpoint: cfg.APIEndpoint,
-		Timeout:  cfg.Timeout,
+		Token:        cfg.LinearAPIKey,
+		Endpoint:     cfg.Endpoint(),
+		Timeout:      cfg.Timeout,
+		RefreshToken: cfg.APIKeySource.RefreshFunc(),
+		TokenSource:  cfg.TokenSource,
 	})
 
+	if headless {
+		code := cli.Run(context.Background(), apiClient, args, os.Stdin, os.Stdout, os.Stderr)
+		logger.Info("app.main: cli command finished command=%s exit_code=%d", args[0], code)
+		if closeErr := logger.Close(); closeErr != nil {
+			fmt.Fprintf(os.Stderr, "Error closing logger: %v\n", closeErr)
+		}
+		os.Exit(code) //nolint:gocritic // defer cleanup handled explicitly above
+	}
+
 	promptTemplates := config.DefaultAgentPromptTemplates()
 	promptsPath, err := config.PromptTemplatesFilePath()
 	if err != nil {
@@ -79,6 +113,25 @@ func main() {
 	// Create and run tview application
 	app := tui.NewApp(apiClient, cfg, promptTemplates)
 
+	// Saved views: named filters shown in the navigation tree
+	if viewsPath, err := config.ViewsFilePath(); err != nil {
+		logger.Warning("app.main: failed to resolve views file path: %v", err)
+	} else if views, err := config.LoadSavedViews(viewsPath); err != nil {
+		logger.Warning("app.main: failed to load views file path=%s error=%v", viewsPath, err)
+	} else {
+		app.SetSavedViews(views)
+	}
+
+	// Agent run history: one transcript file per run
+	if runsDir, err := config.AgentRunsDirPath(); err != nil {
+		logger.Warning("app.main: failed to resolve agent runs directory: %v", err)
+	} else {
+		app.SetAgentRunStore(agents.NewRunStore(runsDir))
+	}
+
+	// On-disk issue cache and offline queue of the active account
+	app.OpenStores()
+
 	if err := app.Run(); err != nil {
 		logger.ErrorWithErr(err, "app.main: application error")
 		fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
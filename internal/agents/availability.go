@@ -3,16 +3,33 @@ package agents
 import (
 	"fmt"
 	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/logger"
 )
 
-// AvailableProviderKeys returns provider keys with resolvable binaries.
-func AvailableProviderKeys(lookPath func(string) (string, error)) []string {
+// AvailableProviderKeys returns provider keys with resolvable binaries,
+// including custom providers from agent_providers.
+func AvailableProviderKeys(commands []config.AgentCommand, lookPath func(string) (string, error)) []string {
 	providers := []struct {
 		key      string
 		provider Provider
 	}{
 		{key: "cursor", provider: NewCursorProvider(lookPath)},
 		{key: "claude", provider: NewClaudeProvider(lookPath)},
+		{key: "codex", provider: NewCodexProvider(lookPath)},
+		{key: "gemini", provider: NewGeminiProvider(lookPath)},
+	}
+	for _, command := range commands {
+		provider, err := NewCommandProvider(command, lookPath)
+		if err != nil {
+			logger.Warning("agents.availability: skipping agent provider key=%s error=%v", command.Key, err)
+			continue
+		}
+		providers = append(providers, struct {
+			key      string
+			provider Provider
+		}{key: command.Key, provider: provider})
 	}
 
 	available := make([]string, 0, len(providers))
@@ -24,15 +41,22 @@ func AvailableProviderKeys(lookPath func(string) (string, error)) []string {
 	return available
 }
 
-// ProviderForKey constructs a provider for the given config key.
-func ProviderForKey(key string, lookPath func(string) (string, error)) (Provider, error) {
+// ProviderForKey constructs a provider for the given config key. Keys other
+// than the built-in providers are looked up in commands.
+func ProviderForKey(key string, commands []config.AgentCommand, lookPath func(string) (string, error)) (Provider, error) {
 	normalized := strings.ToLower(strings.TrimSpace(key))
 	switch normalized {
 	case "cursor":
 		return NewCursorProvider(lookPath), nil
 	case "claude":
 		return NewClaudeProvider(lookPath), nil
-	default:
-		return nil, fmt.Errorf("invalid agent provider %q", key)
+	case "codex":
+		return NewCodexProvider(lookPath), nil
+	case "gemini":
+		return NewGeminiProvider(lookPath), nil
+	}
+	if command, ok := config.FindAgentCommand(commands, normalized); ok {
+		return NewCommandProvider(command, lookPath)
 	}
+	return nil, fmt.Errorf("invalid agent provider %q", key)
 }
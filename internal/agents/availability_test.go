@@ -45,6 +45,23 @@ func TestAvailableProviderKeys(t *testing.T) {
 			},
 			want: []string{"claude"},
 		},
+		{
+			name: "codex_only",
+			available: map[string]bool{
+				"codex": true,
+			},
+			want: []string{"codex"},
+		},
+		{
+			name: "all_providers",
+			available: map[string]bool{
+				"cursor-agent": true,
+				"claude":       true,
+				"codex":        true,
+				"gemini":       true,
+			},
+			want: []string{"cursor", "claude", "codex", "gemini"},
+		},
 		{
 			name:      "none",
 			available: map[string]bool{},
@@ -54,7 +71,7 @@ func TestAvailableProviderKeys(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := AvailableProviderKeys(stubLookPath(tt.available))
+			got := AvailableProviderKeys(nil, stubLookPath(tt.available))
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Fatalf("AvailableProviderKeys() = %v, want %v", got, tt.want)
 			}
@@ -80,6 +97,16 @@ func TestProviderForKey(t *testing.T) {
 			key:      "  Claude ",
 			wantName: "Claude",
 		},
+		{
+			name:     "codex",
+			key:      "codex",
+			wantName: "Codex",
+		},
+		{
+			name:     "gemini",
+			key:      "gemini",
+			wantName: "Gemini",
+		},
 		{
 			name:    "invalid",
 			key:     "unknown",
@@ -89,7 +116,7 @@ func TestProviderForKey(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			provider, err := ProviderForKey(tt.key, stubLookPath(map[string]bool{}))
+			provider, err := ProviderForKey(tt.key, nil, stubLookPath(map[string]bool{}))
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("ProviderForKey() expected error")
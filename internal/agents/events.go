@@ -16,23 +16,23 @@ const (
 
 // AgentEvent captures a parsed stream event for UI rendering.
 type AgentEvent struct {
-	Type          AgentEventType
-	Subtype       string
-	Text          string
-	Model         string
-	SessionID     string
-	ResumeCommand string
-	DurationMs    int64
-	IsError       bool
-	Tool          *AgentToolCall
+	Type          AgentEventType `json:"type"`
+	Subtype       string         `json:"subtype,omitempty"`
+	Text          string         `json:"text,omitempty"`
+	Model         string         `json:"model,omitempty"`
+	SessionID     string         `json:"session_id,omitempty"`
+	ResumeCommand string         `json:"resume_command,omitempty"`
+	DurationMs    int64          `json:"duration_ms,omitempty"`
+	IsError       bool           `json:"is_error,omitempty"`
+	Tool          *AgentToolCall `json:"tool,omitempty"`
 }
 
 // AgentToolCall captures tool call details for display.
 type AgentToolCall struct {
-	Name    string
-	Path    string
-	Status  string
-	Summary string
+	Name    string `json:"name"`
+	Path    string `json:"path,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Summary string `json:"summary,omitempty"`
 }
 
 // EventParser allows providers to emit structured events.
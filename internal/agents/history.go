@@ -0,0 +1,233 @@
+package agents
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Run statuses.
+const (
+	RunStatusRunning   = "running"
+	RunStatusCompleted = "completed"
+	RunStatusFailed    = "failed"
+	RunStatusCanceled  = "canceled"
+)
+
+// RunRecord is the persisted transcript of one agent run.
+type RunRecord struct {
+	ID              string       `json:"id"`
+	IssueID         string       `json:"issue_id"`
+	IssueIdentifier string       `json:"issue_identifier,omitempty"`
+	Provider        string       `json:"provider"`      // Config key, e.g. claude
+	ProviderName    string       `json:"provider_name"` // Display name, e.g. Claude
+	Model           string       `json:"model,omitempty"`
+	Sandbox         string       `json:"sandbox,omitempty"`
+	Prompt          string       `json:"prompt"`
+	Workspace       string       `json:"workspace,omitempty"`
+	StartedAt       time.Time    `json:"started_at"`
+	EndedAt         *time.Time   `json:"ended_at,omitempty"`
+	Status          string       `json:"status"`
+	ExitCode        int          `json:"exit_code"`
+	Error           string       `json:"error,omitempty"`
+	SessionID       string       `json:"session_id,omitempty"`
+	ResumeCommand   string       `json:"resume_command,omitempty"`
+	Events          []AgentEvent `json:"events,omitempty"`
+	Lines           []string     `json:"lines,omitempty"` // Output lines that were not parsed into events
+}
+
+// RunStore keeps one JSON file per agent run in a directory.
+type RunStore struct {
+	dir string
+	now func() time.Time
+}
+
+// NewRunStore creates a store for the run files in dir.
+func NewRunStore(dir string) *RunStore {
+	return &RunStore{dir: dir, now: time.Now}
+}
+
+// Start assigns an ID and start time to record, saves it as running, and
+// returns a recorder that collects the run's output.
+func (s *RunStore) Start(record RunRecord) (*RunRecorder, error) {
+	id, err := newRunID(s.now())
+	if err != nil {
+		return nil, err
+	}
+	record.ID = id
+	record.StartedAt = s.now()
+	record.Status = RunStatusRunning
+	if err := s.Save(record); err != nil {
+		return nil, err
+	}
+	return &RunRecorder{store: s, record: record}, nil
+}
+
+// Save writes a run record, replacing an earlier version of it.
+func (s *RunStore) Save(record RunRecord) error {
+	if record.ID == "" {
+		return fmt.Errorf("run id is empty")
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("create runs directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal run: %w", err)
+	}
+	tmp, err := os.CreateTemp(s.dir, ".run-*")
+	if err != nil {
+		return fmt.Errorf("create run file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write run file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write run file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path(record.ID)); err != nil {
+		return fmt.Errorf("replace run file: %w", err)
+	}
+	return nil
+}
+
+// Load reads the run with the given ID.
+func (s *RunStore) Load(id string) (RunRecord, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return RunRecord{}, fmt.Errorf("invalid run id %q", id)
+	}
+	data, err := os.ReadFile(s.path(id))
+	if err != nil {
+		return RunRecord{}, fmt.Errorf("read run %s: %w", id, err)
+	}
+	var record RunRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return RunRecord{}, fmt.Errorf("parse run %s: %w", id, err)
+	}
+	return record, nil
+}
+
+// List returns the runs of an issue, newest first. An empty issueID lists
+// every run. Unreadable files are skipped.
+func (s *RunStore) List(issueID string) ([]RunRecord, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read runs directory: %w", err)
+	}
+
+	var records []RunRecord
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != ".json" {
+			continue
+		}
+		record, err := s.Load(strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			continue
+		}
+		if issueID != "" && record.IssueID != issueID {
+			continue
+		}
+		records = append(records, record)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StartedAt.After(records[j].StartedAt)
+	})
+	return records, nil
+}
+
+// path returns the file of a run.
+func (s *RunStore) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+// RunRecorder collects the output of a running agent. It is safe for
+// concurrent use by the stdout and stderr readers.
+type RunRecorder struct {
+	store *RunStore
+
+	mu     sync.Mutex
+	record RunRecord
+}
+
+// AddEvent records a parsed event.
+func (r *RunRecorder) AddEvent(event AgentEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.record.Events = append(r.record.Events, event)
+	if event.SessionID != "" {
+		r.record.SessionID = event.SessionID
+	}
+	if event.ResumeCommand != "" {
+		r.record.ResumeCommand = event.ResumeCommand
+	}
+}
+
+// AddLine records an output line that was not parsed into an event.
+func (r *RunRecorder) AddLine(line string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.record.Lines = append(r.record.Lines, line)
+}
+
+// Finish records the outcome of the run and saves it. runErr is the error
+// returned by Runner.Run and ctx the context the run was started with.
+func (r *RunRecorder) Finish(ctx context.Context, runErr error) (RunRecord, error) {
+	r.mu.Lock()
+	ended := r.store.now()
+	r.record.EndedAt = &ended
+	switch {
+	case ctx.Err() != nil:
+		r.record.Status = RunStatusCanceled
+	case runErr != nil:
+		r.record.Status = RunStatusFailed
+		r.record.Error = runErr.Error()
+	case resultFailed(r.record.Events):
+		r.record.Status = RunStatusFailed
+	default:
+		r.record.Status = RunStatusCompleted
+	}
+	var exitErr *exec.ExitError
+	if errors.As(runErr, &exitErr) {
+		r.record.ExitCode = exitErr.ExitCode()
+	}
+	record := r.record
+	r.mu.Unlock()
+
+	return record, r.store.Save(record)
+}
+
+// resultFailed reports whether the agent reported its result as an error.
+func resultFailed(events []AgentEvent) bool {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == AgentEventResult {
+			return events[i].IsError
+		}
+	}
+	return false
+}
+
+// newRunID returns a sortable, unique run ID.
+func newRunID(now time.Time) (string, error) {
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("generate run id: %w", err)
+	}
+	return now.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix), nil
+}
@@ -0,0 +1,141 @@
+package agents
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fixedRunStore returns a store whose clock advances a minute per call.
+func fixedRunStore(t *testing.T) *RunStore {
+	t.Helper()
+	store := NewRunStore(filepath.Join(t.TempDir(), "runs"))
+	now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	store.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+	return store
+}
+
+// TestRunStore_RecordsTranscript verifies a run is saved with its events.
+func TestRunStore_RecordsTranscript(t *testing.T) {
+	store := fixedRunStore(t)
+
+	recorder, err := store.Start(RunRecord{IssueID: "issue-1", IssueIdentifier: "ENG-1", Provider: "claude", ProviderName: "Claude", Prompt: "Summarize"})
+	if err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+
+	// A crash mid-run leaves the run marked as running
+	records, err := store.List("issue-1")
+	if err != nil || len(records) != 1 || records[0].Status != RunStatusRunning {
+		t.Fatalf("List() during run = %+v, %v", records, err)
+	}
+
+	recorder.AddEvent(AgentEvent{Type: AgentEventSystem, SessionID: "abc", ResumeCommand: "claude --resume abc"})
+	recorder.AddEvent(AgentEvent{Type: AgentEventAssistant, Text: "Summary"})
+	recorder.AddEvent(AgentEvent{Type: AgentEventToolCall, Tool: &AgentToolCall{Name: "Read", Path: "README.md"}})
+	recorder.AddEvent(AgentEvent{Type: AgentEventResult, Subtype: "success", DurationMs: 1200})
+	recorder.AddLine("stderr: warning")
+
+	record, err := recorder.Finish(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Finish() error: %v", err)
+	}
+	if record.Status != RunStatusCompleted || record.SessionID != "abc" || record.ResumeCommand != "claude --resume abc" {
+		t.Errorf("finished record = %+v", record)
+	}
+	if record.EndedAt == nil || !record.EndedAt.After(record.StartedAt) {
+		t.Errorf("run times = %v - %v", record.StartedAt, record.EndedAt)
+	}
+
+	loaded, err := store.Load(record.ID)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Events, record.Events) || !reflect.DeepEqual(loaded.Lines, []string{"stderr: warning"}) {
+		t.Errorf("loaded transcript = %+v / %q", loaded.Events, loaded.Lines)
+	}
+	if !loaded.StartedAt.Equal(record.StartedAt) || loaded.Prompt != "Summarize" || loaded.IssueIdentifier != "ENG-1" {
+		t.Errorf("loaded record = %+v", loaded)
+	}
+}
+
+// TestRunStore_ListNewestFirst verifies runs are filtered by issue and sorted.
+func TestRunStore_ListNewestFirst(t *testing.T) {
+	store := fixedRunStore(t)
+	var ids []string
+	for _, issueID := range []string{"issue-1", "issue-2", "issue-1"} {
+		recorder, err := store.Start(RunRecord{IssueID: issueID, Provider: "codex"})
+		if err != nil {
+			t.Fatalf("Start() error: %v", err)
+		}
+		record, err := recorder.Finish(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("Finish() error: %v", err)
+		}
+		ids = append(ids, record.ID)
+	}
+	// Stray files are ignored
+	if err := os.WriteFile(filepath.Join(store.dir, "notes.txt"), []byte("x"), 0644); err != nil {
+		t.Fatalf("write stray file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(store.dir, "broken.json"), []byte("{"), 0644); err != nil {
+		t.Fatalf("write broken file: %v", err)
+	}
+
+	records, err := store.List("issue-1")
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(records) != 2 || records[0].ID != ids[2] || records[1].ID != ids[0] {
+		t.Errorf("List(issue-1) = %+v, want runs %s and %s newest first", records, ids[2], ids[0])
+	}
+	if all, _ := store.List(""); len(all) != 3 {
+		t.Errorf("List(\"\") returned %d runs, want 3", len(all))
+	}
+
+	empty := NewRunStore(filepath.Join(t.TempDir(), "missing"))
+	if records, err := empty.List(""); err != nil || len(records) != 0 {
+		t.Errorf("List() on missing directory = %+v, %v", records, err)
+	}
+	if _, err := store.Load("../config"); err == nil {
+		t.Error("Load() expected error for path-like id")
+	}
+}
+
+// TestRunRecorder_FinishStatus verifies failed, errored, and canceled runs.
+func TestRunRecorder_FinishStatus(t *testing.T) {
+	store := fixedRunStore(t)
+
+	exitErr := exec.Command("sh", "-c", "exit 3").Run()
+	var asExit *exec.ExitError
+	if !errors.As(exitErr, &asExit) {
+		t.Skipf("sh unavailable: %v", exitErr)
+	}
+
+	recorder, _ := store.Start(RunRecord{IssueID: "issue-1"})
+	record, err := recorder.Finish(context.Background(), exitErr)
+	if err != nil || record.Status != RunStatusFailed || record.ExitCode != 3 || record.Error == "" {
+		t.Errorf("failed run = %+v, %v", record, err)
+	}
+
+	recorder, _ = store.Start(RunRecord{IssueID: "issue-1"})
+	recorder.AddEvent(AgentEvent{Type: AgentEventResult, IsError: true})
+	if record, _ := recorder.Finish(context.Background(), nil); record.Status != RunStatusFailed {
+		t.Errorf("result error status = %q, want failed", record.Status)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	recorder, _ = store.Start(RunRecord{IssueID: "issue-1"})
+	if record, _ := recorder.Finish(ctx, errors.New("signal: killed")); record.Status != RunStatusCanceled {
+		t.Errorf("canceled status = %q, want canceled", record.Status)
+	}
+}
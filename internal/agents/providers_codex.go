@@ -0,0 +1,292 @@
+package agents
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// CodexProvider invokes the OpenAI Codex CLI.
+type CodexProvider struct {
+	lookPath func(string) (string, error)
+	now      func() time.Time
+
+	mu      sync.Mutex
+	started time.Time
+}
+
+// NewCodexProvider creates a Codex provider with an optional lookPath override.
+func NewCodexProvider(lookPath func(string) (string, error)) *CodexProvider {
+	if lookPath == nil {
+		lookPath = exec.LookPath
+	}
+	return &CodexProvider{
+		lookPath: lookPath,
+		now:      time.Now,
+	}
+}
+
+// Name returns the display name for this provider.
+func (p *CodexProvider) Name() string {
+	return "Codex"
+}
+
+// ResolveBinary finds the Codex CLI binary.
+func (p *CodexProvider) ResolveBinary() (string, bool) {
+	path, err := p.lookPath("codex")
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// BuildArgs builds argv for a non-interactive `codex exec` run.
+func (p *CodexProvider) BuildArgs(prompt string, issueContext string, options AgentRunOptions) []string {
+	fullPrompt := buildAgentPrompt(prompt, issueContext)
+	args := []string{"exec", "--json", "--skip-git-repo-check"}
+	if options.Model != "" {
+		args = append(args, "--model", options.Model)
+	}
+	switch strings.ToLower(strings.TrimSpace(options.Sandbox)) {
+	case "enabled":
+		args = append(args, "--sandbox", "workspace-write")
+	case "disabled":
+		args = append(args, "--dangerously-bypass-approvals-and-sandbox")
+	}
+	if options.Workspace != "" {
+		args = append(args, "--cd", options.Workspace)
+	}
+	args = append(args, fullPrompt)
+	return args
+}
+
+// ParseEvent parses a `codex exec --json` line into an AgentEvent.
+func (p *CodexProvider) ParseEvent(line []byte) (*AgentEvent, bool) {
+	trimmed := strings.TrimSpace(string(line))
+	if trimmed == "" || !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+
+	var event codexStreamEvent
+	if err := json.Unmarshal([]byte(trimmed), &event); err != nil {
+		logger.ErrorWithErr(err, "agents.codex: failed to parse stream event")
+		return nil, false
+	}
+
+	switch event.Type {
+	case "thread.started":
+		p.mu.Lock()
+		p.started = p.now()
+		p.mu.Unlock()
+		return &AgentEvent{
+			Type:          AgentEventSystem,
+			Subtype:       "init",
+			SessionID:     event.ThreadID,
+			ResumeCommand: buildCodexResumeCommand(event.ThreadID),
+		}, true
+	case "item.started", "item.completed":
+		if event.Item == nil {
+			return nil, false
+		}
+		return parseCodexItem(*event.Item, strings.TrimPrefix(event.Type, "item."))
+	case "turn.completed":
+		if event.Usage != nil {
+			logger.Debug("agents.codex: turn completed input_tokens=%d output_tokens=%d",
+				event.Usage.InputTokens, event.Usage.OutputTokens)
+		}
+		return &AgentEvent{
+			Type:       AgentEventResult,
+			Subtype:    "success",
+			DurationMs: p.elapsedMs(),
+		}, true
+	case "turn.failed", "error":
+		message := strings.TrimSpace(event.Message)
+		if event.Error != nil {
+			message = strings.TrimSpace(event.Error.Message)
+		}
+		logger.Error("agents.codex: run failed type=%s error=%s", event.Type, message)
+		return &AgentEvent{
+			Type:       AgentEventResult,
+			Subtype:    "error",
+			Text:       message,
+			DurationMs: p.elapsedMs(),
+			IsError:    true,
+		}, true
+	}
+
+	return nil, false
+}
+
+// ParseStreamLine formats parsed events and hides the remaining JSON events
+// (turn.started, item.updated, ...), which carry nothing to display.
+func (p *CodexProvider) ParseStreamLine(line []byte) (string, bool) {
+	if event, ok := p.ParseEvent(line); ok && event != nil {
+		return formatEventLine(*event), true
+	}
+	trimmed := strings.TrimSpace(string(line))
+	if strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed)) {
+		return "", true
+	}
+	return "", false
+}
+
+// elapsedMs returns the time since the thread started, in milliseconds.
+func (p *CodexProvider) elapsedMs() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.started.IsZero() {
+		return 0
+	}
+	return p.now().Sub(p.started).Milliseconds()
+}
+
+// parseCodexItem converts a thread item into an event. status is started or
+// completed.
+func parseCodexItem(item codexItem, status string) (*AgentEvent, bool) {
+	switch item.Type {
+	case "agent_message":
+		if status != "completed" || strings.TrimSpace(item.Text) == "" {
+			return nil, false
+		}
+		return &AgentEvent{Type: AgentEventAssistant, Text: strings.TrimSpace(item.Text)}, true
+	case "reasoning":
+		if status != "completed" || strings.TrimSpace(item.Text) == "" {
+			return nil, false
+		}
+		return &AgentEvent{Type: AgentEventThinking, Text: strings.TrimSpace(item.Text)}, true
+	case "command_execution":
+		tool := &AgentToolCall{Name: "exec", Path: strings.TrimSpace(item.Command), Status: status}
+		if status == "completed" {
+			tool.Summary = summarizeCodexCommand(item)
+		}
+		return &AgentEvent{Type: AgentEventToolCall, Subtype: status, Tool: tool}, true
+	case "file_change":
+		if item.Status == "failed" {
+			logger.Error("agents.codex: patch failed files=%s", codexChangedPaths(item.Changes))
+		}
+		tool := &AgentToolCall{Name: "patch", Path: codexChangedPaths(item.Changes), Status: status}
+		if status == "completed" {
+			tool.Summary = summarizeCodexPatch(item)
+		}
+		return &AgentEvent{Type: AgentEventToolCall, Subtype: status, Tool: tool}, true
+	case "mcp_tool_call":
+		name := strings.Trim(strings.TrimSpace(item.Server)+"."+strings.TrimSpace(item.Tool), ".")
+		if name == "" {
+			name = "mcp"
+		}
+		tool := &AgentToolCall{Name: name, Status: status}
+		if status == "completed" && item.Status == "failed" {
+			tool.Summary = "(failed)"
+		}
+		return &AgentEvent{Type: AgentEventToolCall, Subtype: status, Tool: tool}, true
+	case "web_search":
+		return &AgentEvent{
+			Type:    AgentEventToolCall,
+			Subtype: status,
+			Tool:    &AgentToolCall{Name: "web_search", Path: strings.TrimSpace(item.Query), Status: status},
+		}, true
+	case "error":
+		if strings.TrimSpace(item.Message) == "" {
+			return nil, false
+		}
+		return &AgentEvent{Type: AgentEventUnknown, Text: "error: " + strings.TrimSpace(item.Message)}, true
+	}
+	return nil, false
+}
+
+// summarizeCodexCommand summarizes a finished command by exit code and output size.
+func summarizeCodexCommand(item codexItem) string {
+	parts := []string{}
+	if item.ExitCode != nil {
+		parts = append(parts, fmt.Sprintf("exit %d", *item.ExitCode))
+	}
+	if output := strings.TrimRight(item.AggregatedOutput, "\n"); output != "" {
+		parts = append(parts, fmt.Sprintf("%d lines", strings.Count(output, "\n")+1))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%s)", strings.Join(parts, ", "))
+}
+
+// summarizeCodexPatch lists the kind of change made to each file.
+func summarizeCodexPatch(item codexItem) string {
+	if item.Status == "failed" {
+		return "(failed)"
+	}
+	counts := map[string]int{}
+	order := []string{}
+	for _, change := range item.Changes {
+		if counts[change.Kind] == 0 {
+			order = append(order, change.Kind)
+		}
+		counts[change.Kind]++
+	}
+	parts := make([]string, 0, len(order))
+	for _, kind := range order {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[kind], kind))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%s)", strings.Join(parts, ", "))
+}
+
+// codexChangedPaths joins the file paths of a patch.
+func codexChangedPaths(changes []codexFileChange) string {
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+	return strings.Join(paths, ", ")
+}
+
+// buildCodexResumeCommand returns a resume command when a thread id is available.
+func buildCodexResumeCommand(threadID string) string {
+	if strings.TrimSpace(threadID) == "" {
+		return ""
+	}
+	return fmt.Sprintf("codex resume %s", threadID)
+}
+
+// codexStreamEvent captures the fields of `codex exec --json` events.
+type codexStreamEvent struct {
+	Type     string     `json:"type"`
+	ThreadID string     `json:"thread_id"`
+	Message  string     `json:"message"`
+	Item     *codexItem `json:"item"`
+	Usage    *struct {
+		InputTokens  int64 `json:"input_tokens"`
+		OutputTokens int64 `json:"output_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// codexItem captures thread items such as messages, commands, and patches.
+type codexItem struct {
+	ID               string            `json:"id"`
+	Type             string            `json:"type"`
+	Text             string            `json:"text"`
+	Command          string            `json:"command"`
+	AggregatedOutput string            `json:"aggregated_output"`
+	ExitCode         *int              `json:"exit_code"`
+	Status           string            `json:"status"`
+	Changes          []codexFileChange `json:"changes"`
+	Server           string            `json:"server"`
+	Tool             string            `json:"tool"`
+	Query            string            `json:"query"`
+	Message          string            `json:"message"`
+}
+
+// codexFileChange is one file of a patch.
+type codexFileChange struct {
+	Path string `json:"path"`
+	Kind string `json:"kind"`
+}
@@ -0,0 +1,128 @@
+package agents
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCodexProvider_BuildArgs verifies exec mode, JSON output, and options.
+func TestCodexProvider_BuildArgs(t *testing.T) {
+	provider := NewCodexProvider(nil)
+	args := provider.BuildArgs("Do the thing", "Context text", AgentRunOptions{
+		Sandbox:   "enabled",
+		Model:     "gpt-5-codex",
+		Workspace: "/tmp/workspace",
+	})
+
+	want := []string{
+		"exec", "--json", "--skip-git-repo-check",
+		"--model", "gpt-5-codex",
+		"--sandbox", "workspace-write",
+		"--cd", "/tmp/workspace",
+		buildAgentPrompt("Do the thing", "Context text"),
+	}
+	if !reflect.DeepEqual(args, want) {
+		t.Fatalf("BuildArgs() = %q, want %q", args, want)
+	}
+
+	args = provider.BuildArgs("Do the thing", "Context text", AgentRunOptions{Sandbox: "disabled"})
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "--dangerously-bypass-approvals-and-sandbox") || strings.Contains(joined, "--model") {
+		t.Fatalf("unexpected args without sandbox: %s", joined)
+	}
+}
+
+// TestCodexProvider_ParseEvent verifies the exec JSON events map onto agent events.
+func TestCodexProvider_ParseEvent(t *testing.T) {
+	provider := NewCodexProvider(nil)
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	provider.now = func() time.Time { return now }
+
+	tests := []struct {
+		name    string
+		line    string
+		advance time.Duration
+		want    *AgentEvent
+	}{
+		{
+			name: "thread_started",
+			line: `{"type":"thread.started","thread_id":"0199a213"}`,
+			want: &AgentEvent{Type: AgentEventSystem, Subtype: "init", SessionID: "0199a213", ResumeCommand: "codex resume 0199a213"},
+		},
+		{
+			name: "turn_started_is_skipped",
+			line: `{"type":"turn.started"}`,
+		},
+		{
+			name: "reasoning",
+			line: `{"type":"item.completed","item":{"id":"item_0","type":"reasoning","text":"**Scanning the repo**"}}`,
+			want: &AgentEvent{Type: AgentEventThinking, Text: "**Scanning the repo**"},
+		},
+		{
+			name: "command_started",
+			line: `{"type":"item.started","item":{"id":"item_1","type":"command_execution","command":"bash -lc ls","aggregated_output":"","exit_code":null,"status":"in_progress"}}`,
+			want: &AgentEvent{Type: AgentEventToolCall, Subtype: "started", Tool: &AgentToolCall{Name: "exec", Path: "bash -lc ls", Status: "started"}},
+		},
+		{
+			name: "command_completed",
+			line: `{"type":"item.completed","item":{"id":"item_1","type":"command_execution","command":"bash -lc ls","aggregated_output":"a\nb\n","exit_code":1,"status":"failed"}}`,
+			want: &AgentEvent{Type: AgentEventToolCall, Subtype: "completed", Tool: &AgentToolCall{Name: "exec", Path: "bash -lc ls", Status: "completed", Summary: "(exit 1, 2 lines)"}},
+		},
+		{
+			name: "patch_completed",
+			line: `{"type":"item.completed","item":{"id":"item_2","type":"file_change","changes":[{"path":"main.go","kind":"update"},{"path":"new.go","kind":"add"},{"path":"util.go","kind":"update"}],"status":"completed"}}`,
+			want: &AgentEvent{Type: AgentEventToolCall, Subtype: "completed", Tool: &AgentToolCall{Name: "patch", Path: "main.go, new.go, util.go", Status: "completed", Summary: "(2 update, 1 add)"}},
+		},
+		{
+			name: "agent_message",
+			line: `{"type":"item.completed","item":{"id":"item_3","type":"agent_message","text":"Fixed the bug."}}`,
+			want: &AgentEvent{Type: AgentEventAssistant, Text: "Fixed the bug."},
+		},
+		{
+			name:    "turn_completed",
+			line:    `{"type":"turn.completed","usage":{"input_tokens":24763,"cached_input_tokens":24448,"output_tokens":122}}`,
+			advance: 1500 * time.Millisecond,
+			want:    &AgentEvent{Type: AgentEventResult, Subtype: "success", DurationMs: 1500},
+		},
+		{
+			name: "turn_failed",
+			line: `{"type":"turn.failed","error":{"message":"stream disconnected"}}`,
+			want: &AgentEvent{Type: AgentEventResult, Subtype: "error", Text: "stream disconnected", DurationMs: 1500, IsError: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.advance)
+			event, ok := provider.ParseEvent([]byte(tt.line))
+			if tt.want == nil {
+				if ok {
+					t.Fatalf("ParseEvent() = %+v, want no event", event)
+				}
+				return
+			}
+			if !ok || !reflect.DeepEqual(event, tt.want) {
+				t.Fatalf("ParseEvent() = %+v, want %+v", event, tt.want)
+			}
+		})
+	}
+}
+
+// TestCodexProvider_ParseStreamLine verifies skipped JSON events are hidden.
+func TestCodexProvider_ParseStreamLine(t *testing.T) {
+	provider := NewCodexProvider(nil)
+
+	display, ok := provider.ParseStreamLine([]byte(`{"type":"item.completed","item":{"type":"agent_message","text":"Done"}}`))
+	if !ok || display != "Assistant: Done" {
+		t.Fatalf("ParseStreamLine() = %q, %v", display, ok)
+	}
+	if display, ok := provider.ParseStreamLine([]byte(`{"type":"turn.started"}`)); !ok || display != "" {
+		t.Fatalf("ParseStreamLine(turn.started) = %q, %v; want hidden", display, ok)
+	}
+	if _, ok := provider.ParseStreamLine([]byte(`Reading prompt from stdin...`)); ok {
+		t.Fatal("ParseStreamLine() should leave plain text to the raw fallback")
+	}
+}
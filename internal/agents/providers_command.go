@@ -0,0 +1,246 @@
+package agents
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// CommandProvider invokes an agent CLI registered under agent_providers.
+type CommandProvider struct {
+	command  config.AgentCommand
+	lookPath func(string) (string, error)
+	paths    map[string]fieldPath
+}
+
+// NewCommandProvider creates a provider for a custom agent command. It fails
+// when a field path of the event mappings cannot be parsed.
+func NewCommandProvider(command config.AgentCommand, lookPath func(string) (string, error)) (*CommandProvider, error) {
+	if lookPath == nil {
+		lookPath = exec.LookPath
+	}
+
+	paths := make(map[string]fieldPath)
+	for _, mapping := range command.Events {
+		fields := []string{mapping.Text, mapping.Subtype, mapping.Model, mapping.SessionID,
+			mapping.DurationMs, mapping.IsError, mapping.ToolName, mapping.ToolPath}
+		for path := range mapping.Match {
+			fields = append(fields, path)
+		}
+		for _, field := range fields {
+			if field == "" {
+				continue
+			}
+			parsed, err := parseFieldPath(field)
+			if err != nil {
+				return nil, fmt.Errorf("agent provider %s: %w", command.Key, err)
+			}
+			paths[field] = parsed
+		}
+	}
+
+	return &CommandProvider{
+		command:  command,
+		lookPath: lookPath,
+		paths:    paths,
+	}, nil
+}
+
+// Name returns the display name for this provider.
+func (p *CommandProvider) Name() string {
+	return p.command.DisplayName()
+}
+
+// ResolveBinary finds the configured binary.
+func (p *CommandProvider) ResolveBinary() (string, bool) {
+	path, err := p.lookPath(strings.TrimSpace(p.command.Binary))
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// BuildArgs expands the argv template. An argument whose placeholders are all
+// empty is dropped together with the flag directly before it, so templates
+// like ["--model", "{{model}}"] work when no model is selected.
+func (p *CommandProvider) BuildArgs(prompt string, issueContext string, options AgentRunOptions) []string {
+	// A single pass keeps placeholders inside the substituted text literal
+	replacer := strings.NewReplacer(
+		"{{prompt}}", strings.TrimSpace(prompt),
+		"{{context}}", strings.TrimSpace(issueContext),
+		"{{full_prompt}}", buildAgentPrompt(prompt, issueContext),
+		"{{model}}", options.Model,
+		"{{workspace}}", options.Workspace,
+	)
+
+	args := make([]string, 0, len(p.command.Args))
+	previousKept := false
+	for i, arg := range p.command.Args {
+		expanded := replacer.Replace(arg)
+		if expanded == "" && hasPlaceholder(arg) {
+			if i > 0 && previousKept && strings.HasPrefix(p.command.Args[i-1], "-") && !hasPlaceholder(p.command.Args[i-1]) {
+				args = args[:len(args)-1]
+			}
+			previousKept = false
+			continue
+		}
+		args = append(args, expanded)
+		previousKept = true
+	}
+	return args
+}
+
+// ParseEvent maps a JSON line onto an AgentEvent using the first matching
+// event mapping.
+func (p *CommandProvider) ParseEvent(line []byte) (*AgentEvent, bool) {
+	if p.command.Output != config.AgentOutputJSONL {
+		return nil, false
+	}
+	trimmed := strings.TrimSpace(string(line))
+	if trimmed == "" || !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+
+	var object any
+	if err := json.Unmarshal([]byte(trimmed), &object); err != nil {
+		logger.ErrorWithErr(err, "agents.command: failed to parse stream event provider=%s", p.command.Key)
+		return nil, false
+	}
+
+	for _, mapping := range p.command.Events {
+		if !p.matches(object, mapping.Match) {
+			continue
+		}
+		event := &AgentEvent{
+			Type:      AgentEventType(mapping.Type),
+			Text:      strings.TrimSpace(p.field(object, mapping.Text)),
+			Subtype:   p.field(object, mapping.Subtype),
+			Model:     p.field(object, mapping.Model),
+			SessionID: p.field(object, mapping.SessionID),
+			IsError:   p.field(object, mapping.IsError) == "true",
+		}
+		if mapping.Text != "" && event.Text == "" {
+			continue
+		}
+		if value := p.field(object, mapping.DurationMs); value != "" {
+			if duration, err := strconv.ParseFloat(value, 64); err == nil {
+				event.DurationMs = int64(duration)
+			}
+		}
+		if event.Type == AgentEventToolCall {
+			event.Tool = &AgentToolCall{
+				Name:   p.field(object, mapping.ToolName),
+				Path:   p.field(object, mapping.ToolPath),
+				Status: event.Subtype,
+			}
+			if event.Tool.Name == "" {
+				event.Tool.Name = "tool"
+			}
+		}
+		return event, true
+	}
+	return nil, false
+}
+
+// ParseStreamLine hides JSON lines no event mapping matched, since they are
+// protocol noise rather than output. Other lines are shown as they are.
+func (p *CommandProvider) ParseStreamLine(line []byte) (string, bool) {
+	if p.command.Output != config.AgentOutputJSONL {
+		return "", false
+	}
+	trimmed := strings.TrimSpace(string(line))
+	if strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed)) {
+		return "", true
+	}
+	return "", false
+}
+
+// matches reports whether every field path has its expected value.
+func (p *CommandProvider) matches(object any, match map[string]string) bool {
+	for path, want := range match {
+		if p.field(object, path) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// field returns the value at a field path as text. Multiple values (from [])
+// are concatenated.
+func (p *CommandProvider) field(object any, path string) string {
+	if path == "" {
+		return ""
+	}
+	var builder strings.Builder
+	for _, value := range p.paths[path].lookup(object) {
+		builder.WriteString(fieldText(value))
+	}
+	return builder.String()
+}
+
+// hasPlaceholder reports whether an argv template argument uses a placeholder.
+func hasPlaceholder(arg string) bool {
+	return strings.Contains(arg, "{{") && strings.Contains(arg, "}}")
+}
+
+// fieldPath is a parsed jq-style path such as .content[0].text.
+type fieldPath []config.AgentFieldStep
+
+// parseFieldPath parses an event mapping field path (see
+// config.ParseAgentFieldPath).
+func parseFieldPath(path string) (fieldPath, error) {
+	steps, err := config.ParseAgentFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return fieldPath(steps), nil
+}
+
+// lookup returns the values the path selects in a decoded JSON value.
+func (path fieldPath) lookup(value any) []any {
+	values := []any{value}
+	for _, step := range path {
+		var next []any
+		for _, current := range values {
+			switch typed := current.(type) {
+			case map[string]any:
+				if child, ok := typed[step.Key]; ok && step.Key != "" {
+					next = append(next, child)
+				}
+			case []any:
+				if step.All {
+					next = append(next, typed...)
+				} else if step.Key == "" && step.Index < len(typed) {
+					next = append(next, typed[step.Index])
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// fieldText converts a decoded JSON value to display text.
+func fieldText(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case bool:
+		return strconv.FormatBool(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		data, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprintf("%v", typed)
+		}
+		return string(data)
+	}
+}
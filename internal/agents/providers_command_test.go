@@ -0,0 +1,227 @@
+package agents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+)
+
+// jsonlCommand returns a custom provider emitting codex-style JSON lines.
+func jsonlCommand() config.AgentCommand {
+	return config.AgentCommand{
+		Key:    "wrapper",
+		Name:   "Wrapper",
+		Binary: "agent-wrapper",
+		Args:   []string{"exec", "--json", "--model", "{{model}}", "--cd={{workspace}}", "{{full_prompt}}"},
+		Output: config.AgentOutputJSONL,
+		Events: []config.AgentEventMapping{
+			{Match: map[string]string{".type": "session.started"}, Type: "system", SessionID: ".session.id", Model: ".session.model"},
+			{Match: map[string]string{".type": "message"}, Type: "assistant", Text: ".content[].text"},
+			{Match: map[string]string{".type": "tool"}, Type: "tool_call", Subtype: ".status", ToolName: ".name", ToolPath: ".args[0]"},
+			{Match: map[string]string{".type": "done"}, Type: "result", DurationMs: ".stats.duration_ms", IsError: ".failed"},
+		},
+	}
+}
+
+// TestCommandProvider_BuildArgs verifies placeholders are expanded and empty
+// placeholders drop their flag.
+func TestCommandProvider_BuildArgs(t *testing.T) {
+	provider, err := NewCommandProvider(jsonlCommand(), nil)
+	if err != nil {
+		t.Fatalf("NewCommandProvider() error: %v", err)
+	}
+
+	args := provider.BuildArgs("Fix it", "Issue ENG-1", AgentRunOptions{Model: "fast", Workspace: "/tmp/ws"})
+	want := []string{"exec", "--json", "--model", "fast", "--cd=/tmp/ws", buildAgentPrompt("Fix it", "Issue ENG-1")}
+	if !reflect.DeepEqual(args, want) {
+		t.Fatalf("BuildArgs() = %q, want %q", args, want)
+	}
+
+	args = provider.BuildArgs("Fix it", "Issue ENG-1", AgentRunOptions{})
+	want = []string{"exec", "--json", "--cd=", buildAgentPrompt("Fix it", "Issue ENG-1")}
+	if !reflect.DeepEqual(args, want) {
+		t.Fatalf("BuildArgs() without model = %q, want %q", args, want)
+	}
+
+	command := config.AgentCommand{Key: "aider", Binary: "aider", Args: []string{"--message", "{{prompt}}", "--read", "{{context}}"}}
+	provider, err = NewCommandProvider(command, nil)
+	if err != nil {
+		t.Fatalf("NewCommandProvider() error: %v", err)
+	}
+	args = provider.BuildArgs(" Fix it ", "Issue ENG-1", AgentRunOptions{})
+	want = []string{"--message", "Fix it", "--read", "Issue ENG-1"}
+	if !reflect.DeepEqual(args, want) {
+		t.Fatalf("BuildArgs() = %q, want %q", args, want)
+	}
+	if provider.Name() != "aider" {
+		t.Errorf("Name() = %q, want key as display name", provider.Name())
+	}
+}
+
+// TestCommandProvider_BuildArgsKeepsPlaceholdersInText verifies placeholders
+// in the prompt or issue are passed on literally rather than expanded.
+func TestCommandProvider_BuildArgsKeepsPlaceholdersInText(t *testing.T) {
+	command := config.AgentCommand{
+		Key:    "aider",
+		Binary: "aider",
+		Args:   []string{"--message", "{{prompt}}", "--read", "{{context}}", "--model", "{{model}}"},
+	}
+	provider, err := NewCommandProvider(command, nil)
+	if err != nil {
+		t.Fatalf("NewCommandProvider() error: %v", err)
+	}
+
+	want := []string{"--message", "Use {{model}} in {{workspace}}", "--read", "See {{prompt}}", "--model", "fast"}
+	for i := 0; i < 20; i++ {
+		args := provider.BuildArgs("Use {{model}} in {{workspace}}", "See {{prompt}}", AgentRunOptions{Model: "fast", Workspace: "/tmp/ws"})
+		if !reflect.DeepEqual(args, want) {
+			t.Fatalf("BuildArgs() = %q, want %q", args, want)
+		}
+	}
+}
+
+// TestCommandProvider_ParseEvent verifies JSON lines are mapped onto events.
+func TestCommandProvider_ParseEvent(t *testing.T) {
+	provider, err := NewCommandProvider(jsonlCommand(), nil)
+	if err != nil {
+		t.Fatalf("NewCommandProvider() error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		line string
+		want *AgentEvent
+	}{
+		{
+			name: "system",
+			line: `{"type":"session.started","session":{"id":"s-1","model":"m-1"}}`,
+			want: &AgentEvent{Type: AgentEventSystem, SessionID: "s-1", Model: "m-1"},
+		},
+		{
+			name: "assistant_joins_content",
+			line: `{"type":"message","content":[{"text":"Hello "},{"text":"world"}]}`,
+			want: &AgentEvent{Type: AgentEventAssistant, Text: "Hello world"},
+		},
+		{
+			name: "tool_call",
+			line: `{"type":"tool","name":"shell","status":"started","args":["ls -la"]}`,
+			want: &AgentEvent{Type: AgentEventToolCall, Subtype: "started", Tool: &AgentToolCall{Name: "shell", Path: "ls -la", Status: "started"}},
+		},
+		{
+			name: "result",
+			line: `{"type":"done","failed":true,"stats":{"duration_ms":1500}}`,
+			want: &AgentEvent{Type: AgentEventResult, DurationMs: 1500, IsError: true},
+		},
+		{
+			name: "empty_text_is_skipped",
+			line: `{"type":"message","content":[]}`,
+		},
+		{
+			name: "unmatched",
+			line: `{"type":"heartbeat"}`,
+		},
+		{
+			name: "not_json",
+			line: `warning: something`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := provider.ParseEvent([]byte(tt.line))
+			if tt.want == nil {
+				if ok {
+					t.Fatalf("ParseEvent() = %+v, want no event", event)
+				}
+				return
+			}
+			if !ok || !reflect.DeepEqual(event, tt.want) {
+				t.Fatalf("ParseEvent() = %+v, want %+v", event, tt.want)
+			}
+		})
+	}
+
+	// Unmatched JSON is hidden; other lines are shown raw
+	if display, ok := provider.ParseStreamLine([]byte(`{"type":"heartbeat"}`)); !ok || display != "" {
+		t.Errorf("ParseStreamLine(json) = %q, %v; want hidden", display, ok)
+	}
+	if _, ok := provider.ParseStreamLine([]byte(`warning: something`)); ok {
+		t.Error("ParseStreamLine(text) should fall back to the raw line")
+	}
+}
+
+// TestCommandProvider_TextOutput verifies text providers stream raw lines.
+func TestCommandProvider_TextOutput(t *testing.T) {
+	provider, err := NewCommandProvider(config.AgentCommand{Key: "plain", Binary: "plain"}, nil)
+	if err != nil {
+		t.Fatalf("NewCommandProvider() error: %v", err)
+	}
+	if _, ok := provider.ParseEvent([]byte(`{"type":"message"}`)); ok {
+		t.Error("ParseEvent() should not parse text output")
+	}
+	if _, ok := provider.ParseStreamLine([]byte(`{"type":"message"}`)); ok {
+		t.Error("ParseStreamLine() should show text output raw")
+	}
+}
+
+// TestParseFieldPath verifies the supported jq path subset.
+func TestParseFieldPath(t *testing.T) {
+	value := map[string]any{
+		"item": map[string]any{"text": "hi"},
+		"list": []any{map[string]any{"n": 1.0}, map[string]any{"n": 2.5}},
+	}
+
+	tests := []struct {
+		path string
+		want []any
+	}{
+		{path: ".item.text", want: []any{"hi"}},
+		{path: ".list[1].n", want: []any{2.5}},
+		{path: ".list[].n", want: []any{1.0, 2.5}},
+		{path: ".list[5].n", want: nil},
+		{path: ".missing.text", want: nil},
+		{path: ".", want: []any{value}},
+	}
+	for _, tt := range tests {
+		parsed, err := parseFieldPath(tt.path)
+		if err != nil {
+			t.Fatalf("parseFieldPath(%q) error: %v", tt.path, err)
+		}
+		if got := parsed.lookup(value); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("lookup(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	for _, path := range []string{"item", ".item.", ".list[x]", ".list[0", "..item"} {
+		if _, err := parseFieldPath(path); err == nil {
+			t.Errorf("parseFieldPath(%q) expected error", path)
+		}
+	}
+}
+
+// TestProviderForKey_Custom verifies custom providers are looked up by key.
+func TestProviderForKey_Custom(t *testing.T) {
+	commands := []config.AgentCommand{jsonlCommand()}
+
+	provider, err := ProviderForKey(" Wrapper ", commands, stubLookPath(map[string]bool{"agent-wrapper": true}))
+	if err != nil {
+		t.Fatalf("ProviderForKey() error: %v", err)
+	}
+	if provider.Name() != "Wrapper" {
+		t.Errorf("Name() = %q, want Wrapper", provider.Name())
+	}
+	if path, ok := provider.ResolveBinary(); !ok || path != "/bin/agent-wrapper" {
+		t.Errorf("ResolveBinary() = %q, %v", path, ok)
+	}
+
+	got := AvailableProviderKeys(commands, stubLookPath(map[string]bool{"claude": true, "agent-wrapper": true}))
+	if !reflect.DeepEqual(got, []string{"claude", "wrapper"}) {
+		t.Errorf("AvailableProviderKeys() = %v, want [claude wrapper]", got)
+	}
+
+	commands[0].Events[0].Text = "item"
+	if _, err := ProviderForKey("wrapper", commands, nil); err == nil {
+		t.Error("ProviderForKey() expected error for invalid field path")
+	}
+}
@@ -0,0 +1,264 @@
+package agents
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// GeminiProvider invokes Google's Gemini CLI.
+type GeminiProvider struct {
+	lookPath  func(string) (string, error)
+	toolUseMu sync.Mutex
+	toolUses  map[string]geminiToolUseInfo
+}
+
+// NewGeminiProvider creates a Gemini provider with an optional lookPath override.
+func NewGeminiProvider(lookPath func(string) (string, error)) *GeminiProvider {
+	if lookPath == nil {
+		lookPath = exec.LookPath
+	}
+	return &GeminiProvider{
+		lookPath: lookPath,
+		toolUses: make(map[string]geminiToolUseInfo),
+	}
+}
+
+// Name returns the display name for this provider.
+func (p *GeminiProvider) Name() string {
+	return "Gemini"
+}
+
+// ResolveBinary finds the Gemini CLI binary.
+func (p *GeminiProvider) ResolveBinary() (string, bool) {
+	path, err := p.lookPath("gemini")
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// BuildArgs builds argv for a non-interactive Gemini run.
+func (p *GeminiProvider) BuildArgs(prompt string, issueContext string, options AgentRunOptions) []string {
+	fullPrompt := buildAgentPrompt(prompt, issueContext)
+	args := []string{"--output-format", "stream-json"}
+	if options.Model != "" {
+		args = append(args, "--model", options.Model)
+	}
+	if options.Workspace != "" {
+		args = append(args, "--include-directories", options.Workspace)
+	}
+	args = append(args, geminiSandboxArgs(options.Sandbox)...)
+	args = append(args, "--prompt", fullPrompt)
+	return args
+}
+
+// ParseEvent parses a stream-json line into an AgentEvent.
+func (p *GeminiProvider) ParseEvent(line []byte) (*AgentEvent, bool) {
+	trimmed := strings.TrimSpace(string(line))
+	if trimmed == "" || !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+
+	var event geminiStreamEvent
+	if err := json.Unmarshal([]byte(trimmed), &event); err != nil {
+		logger.ErrorWithErr(err, "agents.gemini: failed to parse stream event")
+		return nil, false
+	}
+
+	switch event.Type {
+	case "init":
+		return &AgentEvent{
+			Type:          AgentEventSystem,
+			Subtype:       "init",
+			Model:         event.Model,
+			SessionID:     event.SessionID,
+			ResumeCommand: buildGeminiResumeCommand(event.SessionID),
+		}, true
+	case "message":
+		text := strings.TrimSpace(event.Content)
+		if text == "" {
+			return nil, false
+		}
+		switch {
+		case event.Role == "user":
+			return &AgentEvent{Type: AgentEventUser, Text: text}, true
+		case event.Delta:
+			return &AgentEvent{Type: AgentEventAssistantDelta, Text: text}, true
+		default:
+			return &AgentEvent{Type: AgentEventAssistant, Text: text}, true
+		}
+	case "tool_use":
+		detail := summarizeGeminiToolParameters(event.Parameters)
+		p.rememberToolUse(event.ToolID, event.ToolName, detail)
+		return &AgentEvent{
+			Type:    AgentEventToolCall,
+			Subtype: "started",
+			Tool: &AgentToolCall{
+				Name:   strings.TrimSpace(event.ToolName),
+				Path:   detail,
+				Status: "started",
+			},
+		}, true
+	case "tool_result":
+		info, _ := p.popToolUse(event.ToolID)
+		toolName := strings.TrimSpace(info.Name)
+		if toolName == "" {
+			toolName = "tool"
+		}
+		summary := summarizeGeminiToolOutput(event.Output)
+		if event.Error != nil && strings.TrimSpace(event.Error.Message) != "" {
+			logger.Error("agents.gemini: tool call failed tool=%s error=%s", toolName, event.Error.Message)
+			summary = fmt.Sprintf("(error: %s)", strings.TrimSpace(event.Error.Message))
+		}
+		return &AgentEvent{
+			Type:    AgentEventToolCall,
+			Subtype: "completed",
+			Tool: &AgentToolCall{
+				Name:    toolName,
+				Path:    info.Detail,
+				Status:  "completed",
+				Summary: summary,
+			},
+		}, true
+	case "error":
+		if strings.TrimSpace(event.Message) == "" {
+			return nil, false
+		}
+		logger.Warning("agents.gemini: %s severity=%s", event.Message, event.Severity)
+		return &AgentEvent{
+			Type: AgentEventUnknown,
+			Text: fmt.Sprintf("%s: %s", coalesceText(event.Severity, "error"), strings.TrimSpace(event.Message)),
+		}, true
+	case "result":
+		isError := event.Status != "" && event.Status != "success"
+		if isError {
+			logger.Error("agents.gemini: result error status=%s", event.Status)
+		}
+		result := &AgentEvent{
+			Type:    AgentEventResult,
+			Subtype: event.Status,
+			IsError: isError,
+		}
+		if event.Stats != nil {
+			result.DurationMs = event.Stats.DurationMs
+		}
+		if event.Error != nil {
+			result.Text = strings.TrimSpace(event.Error.Message)
+		}
+		return result, true
+	}
+
+	return nil, false
+}
+
+// ParseStreamLine attempts to extract display text from Gemini stream-json.
+func (p *GeminiProvider) ParseStreamLine(line []byte) (string, bool) {
+	event, ok := p.ParseEvent(line)
+	if !ok || event == nil {
+		return "", false
+	}
+	return formatEventLine(*event), true
+}
+
+// rememberToolUse stores tool metadata for later tool_result correlation.
+func (p *GeminiProvider) rememberToolUse(id string, name string, detail string) {
+	if strings.TrimSpace(id) == "" {
+		return
+	}
+	p.toolUseMu.Lock()
+	p.toolUses[id] = geminiToolUseInfo{Name: name, Detail: detail}
+	p.toolUseMu.Unlock()
+}
+
+// popToolUse returns stored tool metadata and removes it from the cache.
+func (p *GeminiProvider) popToolUse(id string) (geminiToolUseInfo, bool) {
+	p.toolUseMu.Lock()
+	defer p.toolUseMu.Unlock()
+	if info, ok := p.toolUses[id]; ok {
+		delete(p.toolUses, id)
+		return info, true
+	}
+	return geminiToolUseInfo{}, false
+}
+
+// geminiSandboxArgs maps sandbox settings to Gemini flags. With sandboxing
+// enabled tools run in Gemini's sandbox; disabled auto-approves all tools.
+func geminiSandboxArgs(sandbox string) []string {
+	switch strings.ToLower(strings.TrimSpace(sandbox)) {
+	case "enabled":
+		return []string{"--sandbox"}
+	case "disabled":
+		return []string{"--yolo"}
+	default:
+		return nil
+	}
+}
+
+// buildGeminiResumeCommand returns a resume command when a session id is available.
+func buildGeminiResumeCommand(sessionID string) string {
+	if strings.TrimSpace(sessionID) == "" {
+		return ""
+	}
+	return fmt.Sprintf("gemini --resume %s", sessionID)
+}
+
+// summarizeGeminiToolParameters extracts a concise detail string from tool parameters.
+func summarizeGeminiToolParameters(parameters map[string]any) string {
+	for _, key := range []string{"file_path", "absolute_path", "path", "dir_path", "pattern", "command", "url", "query"} {
+		if value, ok := parameters[key]; ok {
+			return strings.TrimSpace(fmt.Sprintf("%v", value))
+		}
+	}
+	return ""
+}
+
+// summarizeGeminiToolOutput shortens tool output to its first line.
+func summarizeGeminiToolOutput(output string) string {
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return ""
+	}
+	if index := strings.IndexByte(output, '\n'); index >= 0 {
+		return fmt.Sprintf("%s (+%d lines)", strings.TrimSpace(output[:index]), strings.Count(output[index:], "\n"))
+	}
+	return output
+}
+
+// geminiToolUseInfo stores tool metadata for result correlation.
+type geminiToolUseInfo struct {
+	Name   string
+	Detail string
+}
+
+// geminiStreamEvent captures Gemini stream-json fields.
+type geminiStreamEvent struct {
+	Type       string         `json:"type"`
+	SessionID  string         `json:"session_id"`
+	Model      string         `json:"model"`
+	Role       string         `json:"role"`
+	Content    string         `json:"content"`
+	Delta      bool           `json:"delta"`
+	ToolName   string         `json:"tool_name"`
+	ToolID     string         `json:"tool_id"`
+	Parameters map[string]any `json:"parameters"`
+	Status     string         `json:"status"`
+	Output     string         `json:"output"`
+	Severity   string         `json:"severity"`
+	Message    string         `json:"message"`
+	Error      *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+	Stats *struct {
+		TotalTokens  int64 `json:"total_tokens"`
+		InputTokens  int64 `json:"input_tokens"`
+		OutputTokens int64 `json:"output_tokens"`
+		DurationMs   int64 `json:"duration_ms"`
+		ToolCalls    int64 `json:"tool_calls"`
+	} `json:"stats"`
+}
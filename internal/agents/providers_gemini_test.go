@@ -0,0 +1,174 @@
+package agents
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestGeminiProvider_BuildArgs verifies CLI args include stream-json and the prompt.
+func TestGeminiProvider_BuildArgs(t *testing.T) {
+	provider := NewGeminiProvider(nil)
+	options := AgentRunOptions{
+		Sandbox:   "enabled",
+		Model:     "gemini-2.5-pro",
+		Workspace: "/tmp/workspace",
+	}
+	args := provider.BuildArgs("Do the thing", "Context text", options)
+
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "--output-format stream-json") {
+		t.Fatalf("expected stream-json output in args: %s", joined)
+	}
+	if !strings.Contains(joined, "--model gemini-2.5-pro") {
+		t.Fatalf("expected model in args: %s", joined)
+	}
+	if !strings.Contains(joined, "--include-directories /tmp/workspace") {
+		t.Fatalf("expected include-directories in args: %s", joined)
+	}
+	if !strings.Contains(joined, "--sandbox") || strings.Contains(joined, "--yolo") {
+		t.Fatalf("expected sandbox flag in args: %s", joined)
+	}
+	if args[len(args)-2] != "--prompt" || !strings.Contains(args[len(args)-1], "Do the thing") || !strings.Contains(args[len(args)-1], "Context text") {
+		t.Fatalf("expected prompt and context as the last argument: %s", joined)
+	}
+
+	args = provider.BuildArgs("Do the thing", "Context text", AgentRunOptions{Sandbox: "disabled"})
+	joined = strings.Join(args, " ")
+	if !strings.Contains(joined, "--yolo") || strings.Contains(joined, "--sandbox") || strings.Contains(joined, "--model") {
+		t.Fatalf("unexpected args with sandbox disabled: %s", joined)
+	}
+}
+
+// TestGeminiProvider_ParseEvent_Init verifies init parsing.
+func TestGeminiProvider_ParseEvent_Init(t *testing.T) {
+	provider := NewGeminiProvider(nil)
+	line := []byte(`{"type":"init","timestamp":"2025-10-10T12:00:00.000Z","session_id":"abc123","model":"gemini-2.5-pro"}`)
+
+	event, ok := provider.ParseEvent(line)
+	if !ok || event == nil {
+		t.Fatalf("expected init event to parse")
+	}
+	if event.Type != AgentEventSystem {
+		t.Fatalf("expected system type, got %s", event.Type)
+	}
+	if event.Model != "gemini-2.5-pro" || event.SessionID != "abc123" {
+		t.Fatalf("unexpected init details: %#v", event)
+	}
+	if event.ResumeCommand != "gemini --resume abc123" {
+		t.Fatalf("expected resume command, got %q", event.ResumeCommand)
+	}
+}
+
+// TestGeminiProvider_ParseEvent_Messages verifies user, delta, and assistant messages.
+func TestGeminiProvider_ParseEvent_Messages(t *testing.T) {
+	provider := NewGeminiProvider(nil)
+	tests := []struct {
+		line     string
+		wantType AgentEventType
+		wantText string
+	}{
+		{`{"type":"message","role":"user","content":"Fix the bug"}`, AgentEventUser, "Fix the bug"},
+		{`{"type":"message","role":"assistant","content":"Looking","delta":true}`, AgentEventAssistantDelta, "Looking"},
+		{`{"type":"message","role":"assistant","content":"Done."}`, AgentEventAssistant, "Done."},
+	}
+
+	for _, tt := range tests {
+		event, ok := provider.ParseEvent([]byte(tt.line))
+		if !ok || event == nil {
+			t.Fatalf("expected message to parse: %s", tt.line)
+		}
+		if event.Type != tt.wantType || event.Text != tt.wantText {
+			t.Fatalf("ParseEvent(%s) = %s %q, want %s %q", tt.line, event.Type, event.Text, tt.wantType, tt.wantText)
+		}
+	}
+
+	if _, ok := provider.ParseEvent([]byte(`{"type":"message","role":"assistant","content":""}`)); ok {
+		t.Fatalf("expected empty message to be skipped")
+	}
+}
+
+// TestGeminiProvider_ParseEvent_ToolUseAndResult verifies tool call parsing and correlation.
+func TestGeminiProvider_ParseEvent_ToolUseAndResult(t *testing.T) {
+	provider := NewGeminiProvider(nil)
+	toolUseLine := []byte(`{"type":"tool_use","tool_name":"run_shell_command","tool_id":"shell-1","parameters":{"command":"go test ./..."}}`)
+
+	event, ok := provider.ParseEvent(toolUseLine)
+	if !ok || event == nil {
+		t.Fatalf("expected tool use event to parse")
+	}
+	if event.Type != AgentEventToolCall || event.Subtype != "started" {
+		t.Fatalf("expected started tool call, got %s/%s", event.Type, event.Subtype)
+	}
+	if event.Tool == nil || event.Tool.Name != "run_shell_command" || event.Tool.Path != "go test ./..." {
+		t.Fatalf("unexpected tool details: %#v", event.Tool)
+	}
+
+	toolResultLine := []byte(`{"type":"tool_result","tool_id":"shell-1","status":"success","output":"ok  pkg/a\nok  pkg/b\nok  pkg/c"}`)
+	event, ok = provider.ParseEvent(toolResultLine)
+	if !ok || event == nil {
+		t.Fatalf("expected tool result event to parse")
+	}
+	if event.Subtype != "completed" {
+		t.Fatalf("expected subtype completed, got %q", event.Subtype)
+	}
+	if event.Tool == nil || event.Tool.Name != "run_shell_command" || event.Tool.Path != "go test ./..." {
+		t.Fatalf("unexpected tool details: %#v", event.Tool)
+	}
+	if event.Tool.Summary != "ok  pkg/a (+2 lines)" {
+		t.Fatalf("unexpected tool summary %q", event.Tool.Summary)
+	}
+
+	errorLine := []byte(`{"type":"tool_result","tool_id":"read-1","status":"error","error":{"type":"FILE_NOT_FOUND","message":"File not found"}}`)
+	event, ok = provider.ParseEvent(errorLine)
+	if !ok || event == nil || event.Tool == nil {
+		t.Fatalf("expected tool error to parse")
+	}
+	if event.Tool.Name != "tool" || !strings.Contains(event.Tool.Summary, "File not found") {
+		t.Fatalf("unexpected tool error details: %#v", event.Tool)
+	}
+}
+
+// TestGeminiProvider_ParseEvent_Result verifies result parsing.
+func TestGeminiProvider_ParseEvent_Result(t *testing.T) {
+	provider := NewGeminiProvider(nil)
+	line := []byte(`{"type":"result","status":"success","stats":{"total_tokens":250,"input_tokens":50,"output_tokens":200,"duration_ms":3000,"tool_calls":1}}`)
+
+	event, ok := provider.ParseEvent(line)
+	if !ok || event == nil {
+		t.Fatalf("expected result event to parse")
+	}
+	if event.Type != AgentEventResult || event.Subtype != "success" {
+		t.Fatalf("expected success result, got %s/%s", event.Type, event.Subtype)
+	}
+	if event.DurationMs != 3000 {
+		t.Fatalf("expected duration 3000, got %d", event.DurationMs)
+	}
+	if event.IsError {
+		t.Fatalf("expected isError=false")
+	}
+
+	event, ok = provider.ParseEvent([]byte(`{"type":"result","status":"error","error":{"type":"API_ERROR","message":"quota exceeded"}}`))
+	if !ok || event == nil || !event.IsError || event.Text != "quota exceeded" {
+		t.Fatalf("expected error result, got %#v", event)
+	}
+}
+
+// TestGeminiProvider_ParseStreamLine verifies display formatting.
+func TestGeminiProvider_ParseStreamLine(t *testing.T) {
+	provider := NewGeminiProvider(nil)
+
+	display, ok := provider.ParseStreamLine([]byte(`{"type":"message","role":"assistant","content":"hello"}`))
+	if !ok || display != "Assistant: hello" {
+		t.Fatalf("expected assistant text, got %q (ok=%v)", display, ok)
+	}
+
+	display, ok = provider.ParseStreamLine([]byte(`{"type":"error","severity":"warning","message":"Loop detected"}`))
+	if !ok || display != "Event: warning: Loop detected" {
+		t.Fatalf("expected warning text, got %q (ok=%v)", display, ok)
+	}
+
+	_, ok = provider.ParseStreamLine([]byte("Loaded cached credentials."))
+	if ok {
+		t.Fatalf("expected non-json to return ok=false")
+	}
+}
@@ -0,0 +1,333 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+const (
+	// diskStoreVersion is bumped whenever the on-disk format changes incompatibly.
+	diskStoreVersion = 1
+
+	// FullSyncInterval is how long an issue snapshot is delta-synced before it is
+	// re-fetched in full. Full syncs drop issues that were archived or deleted,
+	// which incremental syncs cannot observe.
+	FullSyncInterval = 24 * time.Hour
+
+	// maxMetadataAge is the maximum age of persisted team metadata that is still
+	// restored into the in-memory cache on startup.
+	maxMetadataAge = 24 * time.Hour
+
+	// watermarkSkew is subtracted from the local clock when a snapshot has no
+	// issues to derive a server-side watermark from.
+	watermarkSkew = time.Minute
+)
+
+// IssueSnapshot is the last known issue list for one navigation scope.
+type IssueSnapshot struct {
+	Issues []linearapi.Issue `json:"issues"`
+	// Watermark is the newest issue updatedAt seen; delta syncs fetch from here.
+	Watermark time.Time `json:"watermark"`
+	// FullSyncAt is when the snapshot was last fetched in full.
+	FullSyncAt time.Time `json:"full_sync_at"`
+}
+
+// NeedsFullSync reports whether the snapshot is too old to be delta-synced.
+func (s IssueSnapshot) NeedsFullSync(now time.Time) bool {
+	return s.FullSyncAt.IsZero() || now.Sub(s.FullSyncAt) > FullSyncInterval
+}
+
+// TeamMetadata is the persisted form of the TeamCache contents.
+type TeamMetadata struct {
+	SavedAt     time.Time                            `json:"saved_at"`
+	Teams       []linearapi.Team                     `json:"teams,omitempty"`
+	CurrentUser *linearapi.User                      `json:"current_user,omitempty"`
+	Users       map[string][]linearapi.User          `json:"users,omitempty"`
+	Projects    map[string][]linearapi.Project       `json:"projects,omitempty"`
+	States      map[string][]linearapi.WorkflowState `json:"states,omitempty"`
+	Labels      map[string][]linearapi.IssueLabel    `json:"labels,omitempty"`
+	Cycles      map[string][]linearapi.Cycle         `json:"cycles,omitempty"`
+}
+
+// diskStoreFile is the JSON layout of the cache file.
+type diskStoreFile struct {
+	Version   int                      `json:"version"`
+	Owner     string                   `json:"owner"`
+	Snapshots map[string]IssueSnapshot `json:"snapshots"`
+	Metadata  TeamMetadata             `json:"metadata"`
+}
+
+// DiskStore persists issue snapshots and team metadata to a JSON file so the
+// TUI can render the last known state instantly on startup.
+type DiskStore struct {
+	path string
+
+	mu   sync.Mutex
+	data diskStoreFile
+
+	// saveMu orders writes so an older save never replaces a newer file
+	saveMu sync.Mutex
+}
+
+// OpenDiskStore loads the cache file at path. owner identifies the workspace
+// credentials the cache belongs to; a file written for a different owner, an
+// older format, or an unreadable file is discarded rather than returned as an error.
+func OpenDiskStore(path, owner string) (*DiskStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("cache path is empty")
+	}
+
+	store := &DiskStore{
+		path: path,
+		data: newDiskStoreFile(owner),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read cache file: %w", err)
+	}
+
+	var file diskStoreFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		logger.Warning("cache.disk: discarding unreadable cache file path=%s error=%v", path, err)
+		return store, nil
+	}
+	if file.Version != diskStoreVersion || file.Owner != owner {
+		logger.Info("cache.disk: discarding cache file for another version or workspace path=%s", path)
+		return store, nil
+	}
+	if file.Snapshots == nil {
+		file.Snapshots = make(map[string]IssueSnapshot)
+	}
+	store.data = file
+
+	logger.Debug("cache.disk: loaded cache file path=%s snapshots=%d", path, len(file.Snapshots))
+	return store, nil
+}
+
+// OwnerKey derives the cache owner for an API endpoint and key without storing the key itself.
+func OwnerKey(endpoint, apiKey string) string {
+	sum := sha256.Sum256([]byte(endpoint + "\x00" + apiKey))
+	return hex.EncodeToString(sum[:16])
+}
+
+func newDiskStoreFile(owner string) diskStoreFile {
+	return diskStoreFile{
+		Version:   diskStoreVersion,
+		Owner:     owner,
+		Snapshots: make(map[string]IssueSnapshot),
+	}
+}
+
+// Snapshot returns the stored snapshot for a scope key.
+func (s *DiskStore) Snapshot(key string) (IssueSnapshot, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot, ok := s.data.Snapshots[key]
+	return snapshot, ok
+}
+
+// PutSnapshot replaces the snapshot for a scope key. Call Save to persist it.
+func (s *DiskStore) PutSnapshot(key string, snapshot IssueSnapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Snapshots[key] = snapshot
+}
+
+// Metadata returns the stored team metadata.
+func (s *DiskStore) Metadata() TeamMetadata {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.Metadata
+}
+
+// PutMetadata replaces the stored team metadata. Call Save to persist it.
+func (s *DiskStore) PutMetadata(metadata TeamMetadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Metadata = metadata
+}
+
+// Save writes the store to disk. The file is replaced atomically so a crash
+// mid-write never leaves a truncated cache behind. Concurrent saves are
+// serialized, so the file always ends up with the latest data.
+func (s *DiskStore) Save() error {
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+
+	s.mu.Lock()
+	data, err := json.Marshal(s.data)
+	s.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("marshal cache: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".cache-*.json")
+	if err != nil {
+		return fmt.Errorf("create temp cache file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("write cache file: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("replace cache file: %w", err)
+	}
+
+	logger.Debug("cache.disk: saved cache file path=%s bytes=%d", s.path, len(data))
+	return nil
+}
+
+// Cacheable reports whether issues fetched with params can be snapshotted.
+// Search results, query terms, and state type filters cannot be re-checked locally
+// during a delta sync.
+func Cacheable(params linearapi.FetchIssuesParams) bool {
+	return strings.TrimSpace(params.Search) == "" && len(params.Query.Terms) == 0 && params.StateType == ""
+}
+
+// IssueScopeKey returns the snapshot key for the navigation scope in params.
+// Paging, ordering, and search are not part of the scope.
+func IssueScopeKey(params linearapi.FetchIssuesParams) string {
+	return strings.Join([]string{
+		"team=" + params.TeamID,
+		"project=" + params.ProjectID,
+		"state=" + params.StateID,
+		"cycle=" + params.CycleID,
+	}, "|")
+}
+
+// DeltaParams returns params for fetching issues changed since the snapshot watermark.
+// Scope filters other than team are dropped so that issues which moved out of the
+// scope are returned too and can be removed by MergeIssueDelta.
+func DeltaParams(params linearapi.FetchIssuesParams, watermark time.Time) linearapi.FetchIssuesParams {
+	return linearapi.FetchIssuesParams{
+		TeamID:       params.TeamID,
+		OrderBy:      string(linearapi.OrderByUpdatedAt),
+		First:        params.First,
+		UpdatedSince: watermark,
+	}
+}
+
+// NewIssueSnapshot builds a snapshot from a full fetch of a scope.
+func NewIssueSnapshot(issues []linearapi.Issue, syncedAt time.Time) IssueSnapshot {
+	snapshot := IssueSnapshot{
+		Issues:     make([]linearapi.Issue, 0, len(issues)),
+		FullSyncAt: syncedAt,
+	}
+	for _, issue := range issues {
+		snapshot.Issues = append(snapshot.Issues, listIssue(issue))
+	}
+	snapshot.Watermark = issuesWatermark(snapshot.Issues, syncedAt)
+	sortIssuesByUpdatedAt(snapshot.Issues)
+	return snapshot
+}
+
+// MergeIssueDelta applies changed issues from a delta fetch to a snapshot.
+// Changed issues replace their previous version; issues that no longer match
+// the scope in params are removed.
+func MergeIssueDelta(snapshot IssueSnapshot, delta []linearapi.Issue, params linearapi.FetchIssuesParams, syncedAt time.Time) IssueSnapshot {
+	changed := make(map[string]linearapi.Issue, len(delta))
+	for _, issue := range delta {
+		changed[issue.ID] = issue
+	}
+
+	merged := IssueSnapshot{
+		Issues:     make([]linearapi.Issue, 0, len(snapshot.Issues)+len(delta)),
+		FullSyncAt: snapshot.FullSyncAt,
+	}
+	for _, issue := range snapshot.Issues {
+		if _, ok := changed[issue.ID]; ok {
+			continue
+		}
+		merged.Issues = append(merged.Issues, issue)
+	}
+	for _, issue := range delta {
+		if matchesScope(issue, params) {
+			merged.Issues = append(merged.Issues, listIssue(issue))
+		}
+	}
+
+	// Out-of-scope changes still advance the watermark so they are not re-fetched
+	merged.Watermark = snapshot.Watermark
+	if deltaWatermark := issuesWatermark(delta, time.Time{}); deltaWatermark.After(merged.Watermark) {
+		merged.Watermark = deltaWatermark
+	}
+	sortIssuesByUpdatedAt(merged.Issues)
+	return merged
+}
+
+// matchesScope reports whether an issue belongs to the navigation scope in params.
+func matchesScope(issue linearapi.Issue, params linearapi.FetchIssuesParams) bool {
+	if issue.Archived {
+		return false
+	}
+	if params.TeamID != "" && issue.TeamID != params.TeamID {
+		return false
+	}
+	if params.ProjectID != "" && issue.ProjectID != params.ProjectID {
+		return false
+	}
+	if params.StateID != "" && issue.StateID != params.StateID {
+		return false
+	}
+	if params.CycleID != "" && (issue.Cycle == nil || issue.Cycle.ID != params.CycleID) {
+		return false
+	}
+	return true
+}
+
+// listIssue strips fields that are only populated for the details view.
+func listIssue(issue linearapi.Issue) linearapi.Issue {
+	issue.Comments = nil
+	issue.Relations = nil
+	return issue
+}
+
+// issuesWatermark returns the newest updatedAt among issues, or syncedAt minus a
+// small skew when there are none (zero when syncedAt is zero).
+func issuesWatermark(issues []linearapi.Issue, syncedAt time.Time) time.Time {
+	var watermark time.Time
+	for _, issue := range issues {
+		if issue.UpdatedAt.After(watermark) {
+			watermark = issue.UpdatedAt
+		}
+	}
+	if watermark.IsZero() && !syncedAt.IsZero() {
+		watermark = syncedAt.Add(-watermarkSkew)
+	}
+	return watermark
+}
+
+// sortIssuesByUpdatedAt sorts issues newest first, matching the API's default order.
+func sortIssuesByUpdatedAt(issues []linearapi.Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].UpdatedAt.After(issues[j].UpdatedAt)
+	})
+}
@@ -0,0 +1,325 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestOpenDiskStore_MissingFile(t *testing.T) {
+	store, err := OpenDiskStore(filepath.Join(t.TempDir(), "cache.json"), "owner-1")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	if _, ok := store.Snapshot("any"); ok {
+		t.Error("expected no snapshots in a new store")
+	}
+}
+
+func TestDiskStore_SaveAndReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "cache.json")
+	store, err := OpenDiskStore(path, "owner-1")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+
+	syncedAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	store.PutSnapshot("team=t1", NewIssueSnapshot([]linearapi.Issue{
+		{ID: "issue-1", Identifier: "ENG-1", UpdatedAt: syncedAt.Add(-time.Hour)},
+	}, syncedAt))
+	store.PutMetadata(TeamMetadata{SavedAt: syncedAt, Teams: []linearapi.Team{{ID: "t1", Key: "ENG"}}})
+	if err := store.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reopened, err := OpenDiskStore(path, "owner-1")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	snapshot, ok := reopened.Snapshot("team=t1")
+	if !ok || len(snapshot.Issues) != 1 || snapshot.Issues[0].Identifier != "ENG-1" {
+		t.Fatalf("Snapshot() = %+v, %v; want ENG-1", snapshot, ok)
+	}
+	if !snapshot.FullSyncAt.Equal(syncedAt) {
+		t.Errorf("FullSyncAt = %s, want %s", snapshot.FullSyncAt, syncedAt)
+	}
+	if teams := reopened.Metadata().Teams; len(teams) != 1 || teams[0].Key != "ENG" {
+		t.Errorf("Metadata().Teams = %+v, want ENG", teams)
+	}
+}
+
+func TestDiskStore_ConcurrentSavesKeepLatest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	store, err := OpenDiskStore(path, "owner-1")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+
+	const saves = 20
+	var wg sync.WaitGroup
+	for i := 0; i < saves; i++ {
+		store.PutSnapshot(fmt.Sprintf("team=t%d", i), IssueSnapshot{})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := store.Save(); err != nil {
+				t.Errorf("Save() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	reopened, err := OpenDiskStore(path, "owner-1")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	for i := 0; i < saves; i++ {
+		if _, ok := reopened.Snapshot(fmt.Sprintf("team=t%d", i)); !ok {
+			t.Fatalf("snapshot team=t%d missing, an older save replaced the file", i)
+		}
+	}
+}
+
+func TestOpenDiskStore_DiscardsOtherOwner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	store, err := OpenDiskStore(path, "owner-1")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	store.PutSnapshot("key", IssueSnapshot{Issues: []linearapi.Issue{{ID: "issue-1"}}})
+	if err := store.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	other, err := OpenDiskStore(path, "owner-2")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	if _, ok := other.Snapshot("key"); ok {
+		t.Error("expected snapshot from another owner to be discarded")
+	}
+}
+
+func TestOwnerKey(t *testing.T) {
+	key := OwnerKey("https://api.linear.app/graphql", "lin_api_secret")
+	if key == "" || key == OwnerKey("https://api.linear.app/graphql", "lin_api_other") {
+		t.Errorf("OwnerKey() = %q, want distinct non-empty keys per API key", key)
+	}
+	if strings.Contains(key, "secret") {
+		t.Error("OwnerKey() must not contain the API key")
+	}
+}
+
+func TestOpenDiskStore_DiscardsCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	store, err := OpenDiskStore(path, "owner-1")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	if _, ok := store.Snapshot("key"); ok {
+		t.Error("expected empty store for corrupt file")
+	}
+}
+
+func TestNewIssueSnapshot_Watermark(t *testing.T) {
+	syncedAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	newest := syncedAt.Add(-time.Minute * 5)
+	issues := []linearapi.Issue{
+		{ID: "old", UpdatedAt: syncedAt.Add(-time.Hour), Comments: []linearapi.Comment{{ID: "c1"}}},
+		{ID: "new", UpdatedAt: newest},
+	}
+
+	snapshot := NewIssueSnapshot(issues, syncedAt)
+
+	if !snapshot.Watermark.Equal(newest) {
+		t.Errorf("Watermark = %s, want %s", snapshot.Watermark, newest)
+	}
+	if snapshot.Issues[0].ID != "new" {
+		t.Errorf("Issues[0] = %s, want newest first", snapshot.Issues[0].ID)
+	}
+	if snapshot.Issues[1].Comments != nil {
+		t.Error("expected comments to be stripped from snapshot issues")
+	}
+
+	empty := NewIssueSnapshot(nil, syncedAt)
+	if !empty.Watermark.Equal(syncedAt.Add(-watermarkSkew)) {
+		t.Errorf("empty Watermark = %s, want sync time minus skew", empty.Watermark)
+	}
+}
+
+func TestMergeIssueDelta(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := linearapi.FetchIssuesParams{TeamID: "t1", StateID: "todo"}
+	snapshot := IssueSnapshot{
+		Issues: []linearapi.Issue{
+			{ID: "keep", TeamID: "t1", StateID: "todo", UpdatedAt: base},
+			{ID: "update", TeamID: "t1", StateID: "todo", Title: "Old", UpdatedAt: base},
+			{ID: "leave", TeamID: "t1", StateID: "todo", UpdatedAt: base},
+		},
+		Watermark:  base,
+		FullSyncAt: base,
+	}
+	delta := []linearapi.Issue{
+		{ID: "update", TeamID: "t1", StateID: "todo", Title: "New", UpdatedAt: base.Add(time.Hour)},
+		{ID: "leave", TeamID: "t1", StateID: "done", UpdatedAt: base.Add(3 * time.Hour)},
+		{ID: "add", TeamID: "t1", StateID: "todo", UpdatedAt: base.Add(2 * time.Hour)},
+	}
+
+	merged := MergeIssueDelta(snapshot, delta, params, base.Add(4*time.Hour))
+
+	ids := make([]string, 0, len(merged.Issues))
+	for _, issue := range merged.Issues {
+		ids = append(ids, issue.ID)
+	}
+	want := []string{"add", "update", "keep"}
+	if len(ids) != len(want) {
+		t.Fatalf("merged ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("merged ids = %v, want %v", ids, want)
+		}
+	}
+	if merged.Issues[1].Title != "New" {
+		t.Errorf("updated issue title = %q, want New", merged.Issues[1].Title)
+	}
+	if !merged.Watermark.Equal(base.Add(3 * time.Hour)) {
+		t.Errorf("Watermark = %s, want newest delta update including out-of-scope issues", merged.Watermark)
+	}
+	if !merged.FullSyncAt.Equal(base) {
+		t.Errorf("FullSyncAt = %s, want unchanged %s", merged.FullSyncAt, base)
+	}
+}
+
+func TestMatchesScope(t *testing.T) {
+	issue := linearapi.Issue{
+		TeamID:    "t1",
+		ProjectID: "p1",
+		StateID:   "s1",
+		Cycle:     &linearapi.CycleRef{ID: "c1"},
+	}
+
+	tests := []struct {
+		name   string
+		params linearapi.FetchIssuesParams
+		want   bool
+	}{
+		{name: "all issues", params: linearapi.FetchIssuesParams{}, want: true},
+		{name: "team", params: linearapi.FetchIssuesParams{TeamID: "t1"}, want: true},
+		{name: "other team", params: linearapi.FetchIssuesParams{TeamID: "t2"}, want: false},
+		{name: "project", params: linearapi.FetchIssuesParams{ProjectID: "p1"}, want: true},
+		{name: "other state", params: linearapi.FetchIssuesParams{StateID: "s2"}, want: false},
+		{name: "cycle", params: linearapi.FetchIssuesParams{CycleID: "c1"}, want: true},
+		{name: "other cycle", params: linearapi.FetchIssuesParams{CycleID: "c2"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesScope(issue, tt.params); got != tt.want {
+				t.Errorf("matchesScope() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	archived := issue
+	archived.Archived = true
+	if matchesScope(archived, linearapi.FetchIssuesParams{}) {
+		t.Error("archived issues should not match any scope")
+	}
+}
+
+func TestDeltaParams(t *testing.T) {
+	watermark := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := linearapi.FetchIssuesParams{TeamID: "t1", ProjectID: "p1", StateID: "s1", CycleID: "c1", First: 50, OrderBy: "priority"}
+
+	delta := DeltaParams(params, watermark)
+
+	if delta.TeamID != "t1" || delta.ProjectID != "" || delta.StateID != "" || delta.CycleID != "" {
+		t.Errorf("DeltaParams() = %+v, want only team scope", delta)
+	}
+	if !delta.UpdatedSince.Equal(watermark) || delta.First != 50 || delta.OrderBy != "updatedAt" {
+		t.Errorf("DeltaParams() = %+v, want watermark, page size, and updatedAt order", delta)
+	}
+}
+
+func TestCacheableAndScopeKey(t *testing.T) {
+	if Cacheable(linearapi.FetchIssuesParams{Search: "login"}) {
+		t.Error("search results should not be cacheable")
+	}
+	query := linearapi.IssueQuery{Terms: []linearapi.QueryTerm{{Field: linearapi.QueryFieldLabel, Value: "bug"}}}
+	if Cacheable(linearapi.FetchIssuesParams{TeamID: "t1", Query: query}) {
+		t.Error("query results should not be cacheable")
+	}
+	if !Cacheable(linearapi.FetchIssuesParams{TeamID: "t1"}) {
+		t.Error("team scope should be cacheable")
+	}
+
+	a := IssueScopeKey(linearapi.FetchIssuesParams{TeamID: "t1", OrderBy: "createdAt", First: 10})
+	b := IssueScopeKey(linearapi.FetchIssuesParams{TeamID: "t1", OrderBy: "updatedAt", First: 50})
+	if a != b {
+		t.Errorf("scope keys differ by ordering: %q vs %q", a, b)
+	}
+	if a == IssueScopeKey(linearapi.FetchIssuesParams{TeamID: "t1", ProjectID: "p1"}) {
+		t.Error("scope keys should differ by project")
+	}
+}
+
+func TestIssueSnapshot_NeedsFullSync(t *testing.T) {
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	if !(IssueSnapshot{}).NeedsFullSync(now) {
+		t.Error("snapshot without full sync time should need a full sync")
+	}
+	if (IssueSnapshot{FullSyncAt: now.Add(-time.Hour)}).NeedsFullSync(now) {
+		t.Error("recent snapshot should not need a full sync")
+	}
+	if !(IssueSnapshot{FullSyncAt: now.Add(-FullSyncInterval - time.Minute)}).NeedsFullSync(now) {
+		t.Error("old snapshot should need a full sync")
+	}
+}
+
+func TestTeamCache_ExportRestore(t *testing.T) {
+	source := NewTeamCache(nil, 5*time.Minute)
+	source.teams = []linearapi.Team{{ID: "t1"}}
+	source.currentUser = &linearapi.User{ID: "me", IsMe: true}
+	source.states["t1"] = []linearapi.WorkflowState{{ID: "s1", Name: "Todo"}}
+	source.cycles["t1"] = []linearapi.Cycle{{ID: "c1"}}
+
+	metadata := source.Export()
+
+	target := NewTeamCache(nil, 5*time.Minute)
+	target.Restore(metadata)
+
+	if len(target.teams) != 1 || !time.Now().Before(target.teamsExpiry) {
+		t.Errorf("teams not restored: %+v", target.teams)
+	}
+	if target.currentUser == nil || target.currentUser.ID != "me" {
+		t.Errorf("current user not restored: %+v", target.currentUser)
+	}
+	if len(target.states["t1"]) != 1 || len(target.cycles["t1"]) != 1 {
+		t.Errorf("team maps not restored: states=%+v cycles=%+v", target.states, target.cycles)
+	}
+	if _, ok := target.statesExpiry["t1"]; !ok {
+		t.Error("restored states should have an expiry")
+	}
+}
+
+func TestTeamCache_RestoreIgnoresOldMetadata(t *testing.T) {
+	target := NewTeamCache(nil, 5*time.Minute)
+	target.Restore(TeamMetadata{
+		SavedAt: time.Now().Add(-maxMetadataAge - time.Hour),
+		Teams:   []linearapi.Team{{ID: "t1"}},
+	})
+
+	if len(target.teams) != 0 {
+		t.Errorf("expected old metadata to be ignored, got teams %+v", target.teams)
+	}
+}
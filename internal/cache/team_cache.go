@@ -10,7 +10,7 @@ import (
 )
 
 // TeamCache provides TTL-based caching for team-scoped metadata.
-// It caches teams, users, projects, workflow states, and labels to reduce API calls.
+// It caches teams, users, projects, workflow states, labels, and cycles to reduce API calls.
 type TeamCache struct {
 	client *linearapi.Client
 	ttl    time.Duration
@@ -36,6 +36,9 @@ type TeamCache struct {
 	// Label caches (merged team + workspace labels per team)
 	labels       map[string][]linearapi.IssueLabel
 	labelsExpiry map[string]time.Time
+
+	cycles       map[string][]linearapi.Cycle
+	cyclesExpiry map[string]time.Time
 }
 
 // NewTeamCache creates a new team cache with the given client and TTL.
@@ -51,6 +54,8 @@ func NewTeamCache(client *linearapi.Client, ttl time.Duration) *TeamCache {
 		statesExpiry:   make(map[string]time.Time),
 		labels:         make(map[string][]linearapi.IssueLabel),
 		labelsExpiry:   make(map[string]time.Time),
+		cycles:         make(map[string][]linearapi.Cycle),
+		cyclesExpiry:   make(map[string]time.Time),
 	}
 }
 
@@ -159,6 +164,11 @@ func (c *TeamCache) GetIssueLabels(ctx context.Context, teamID string) ([]linear
 	return getCachedOrFetch(ctx, c, teamID, c.labels, c.labelsExpiry, c.client.ListIssueLabels)
 }
 
+// GetCycles returns cached active and upcoming cycles for a team or fetches them from the API.
+func (c *TeamCache) GetCycles(ctx context.Context, teamID string) ([]linearapi.Cycle, error) {
+	return getCachedOrFetch(ctx, c, teamID, c.cycles, c.cyclesExpiry, c.client.ListCycles)
+}
+
 // InvalidateTeams clears the teams cache.
 func (c *TeamCache) InvalidateTeams() {
 	c.mu.Lock()
@@ -199,6 +209,14 @@ func (c *TeamCache) InvalidateIssueLabels(teamID string) {
 	delete(c.labelsExpiry, teamID)
 }
 
+// InvalidateCycles clears the cycles cache for a specific team.
+func (c *TeamCache) InvalidateCycles(teamID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.cycles, teamID)
+	delete(c.cyclesExpiry, teamID)
+}
+
 // InvalidateAll clears all caches.
 func (c *TeamCache) InvalidateAll() {
 	c.mu.Lock()
@@ -217,6 +235,8 @@ func (c *TeamCache) InvalidateAll() {
 	c.statesExpiry = make(map[string]time.Time)
 	c.labels = make(map[string][]linearapi.IssueLabel)
 	c.labelsExpiry = make(map[string]time.Time)
+	c.cycles = make(map[string][]linearapi.Cycle)
+	c.cyclesExpiry = make(map[string]time.Time)
 }
 
 // PreloadTeamMetadata preloads all metadata for a team (users, projects, states, labels).
@@ -266,3 +286,71 @@ func (c *TeamCache) PreloadTeamMetadata(ctx context.Context, teamID string) erro
 
 	return nil
 }
+
+// Export returns a copy of the cached metadata for persisting with a DiskStore.
+func (c *TeamCache) Export() TeamMetadata {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	metadata := TeamMetadata{
+		SavedAt:  time.Now(),
+		Teams:    c.teams,
+		Users:    copyTeamMap(c.users),
+		Projects: copyTeamMap(c.projects),
+		States:   copyTeamMap(c.states),
+		Labels:   copyTeamMap(c.labels),
+		Cycles:   copyTeamMap(c.cycles),
+	}
+	if c.currentUser != nil {
+		user := *c.currentUser
+		metadata.CurrentUser = &user
+	}
+	return metadata
+}
+
+// Restore seeds the cache with persisted metadata so the first lookups after
+// startup are served from disk. Restored entries expire after the normal TTL.
+// Metadata older than maxMetadataAge is ignored.
+func (c *TeamCache) Restore(metadata TeamMetadata) {
+	if metadata.SavedAt.IsZero() || time.Since(metadata.SavedAt) > maxMetadataAge {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiry := time.Now().Add(c.ttl)
+	if len(metadata.Teams) > 0 {
+		c.teams = metadata.Teams
+		c.teamsExpiry = expiry
+	}
+	if metadata.CurrentUser != nil {
+		user := *metadata.CurrentUser
+		c.currentUser = &user
+		c.currentUserExp = expiry
+	}
+	restoreTeamMap(c.users, c.usersExpiry, metadata.Users, expiry)
+	restoreTeamMap(c.projects, c.projectsExpiry, metadata.Projects, expiry)
+	restoreTeamMap(c.states, c.statesExpiry, metadata.States, expiry)
+	restoreTeamMap(c.labels, c.labelsExpiry, metadata.Labels, expiry)
+	restoreTeamMap(c.cycles, c.cyclesExpiry, metadata.Cycles, expiry)
+
+	logger.Debug("cache.team: restored metadata saved_at=%s teams=%d", metadata.SavedAt.Format(time.RFC3339), len(metadata.Teams))
+}
+
+// copyTeamMap returns a shallow copy of a per-team cache map.
+func copyTeamMap[T any](src map[string][]T) map[string][]T {
+	dst := make(map[string][]T, len(src))
+	for teamID, data := range src {
+		dst[teamID] = data
+	}
+	return dst
+}
+
+// restoreTeamMap copies persisted per-team data into a cache map with a shared expiry.
+func restoreTeamMap[T any](cache map[string][]T, expiryMap map[string]time.Time, src map[string][]T, expiry time.Time) {
+	for teamID, data := range src {
+		cache[teamID] = data
+		expiryMap[teamID] = expiry
+	}
+}
@@ -332,3 +332,39 @@ func TestNewTeamCache_LabelsInitialized(t *testing.T) {
 		t.Error("labelsExpiry map should be initialized")
 	}
 }
+
+func TestTeamCache_InvalidateCycles(t *testing.T) {
+	cache := NewTeamCache(nil, 5*time.Minute)
+	cache.cycles["team-1"] = []linearapi.Cycle{{ID: "cycle-1", Number: 1}}
+	cache.cyclesExpiry["team-1"] = time.Now().Add(time.Hour)
+	cache.cycles["team-2"] = []linearapi.Cycle{{ID: "cycle-2", Number: 2}}
+	cache.cyclesExpiry["team-2"] = time.Now().Add(time.Hour)
+
+	cache.InvalidateCycles("team-1")
+
+	if _, ok := cache.cycles["team-1"]; ok {
+		t.Error("team-1 cycles should be removed")
+	}
+	if _, ok := cache.cycles["team-2"]; !ok {
+		t.Error("team-2 cycles should still exist")
+	}
+}
+
+func TestTeamCache_GetCycles_CacheHit(t *testing.T) {
+	cache := NewTeamCache(nil, 5*time.Minute)
+
+	teamID := "team-1"
+	cache.cycles[teamID] = []linearapi.Cycle{
+		{ID: "cycle-1", Number: 12, IsActive: true},
+		{ID: "cycle-2", Number: 13},
+	}
+	cache.cyclesExpiry[teamID] = time.Now().Add(time.Hour)
+
+	cycles, err := cache.GetCycles(context.Background(), teamID)
+	if err != nil {
+		t.Fatalf("GetCycles() error = %v", err)
+	}
+	if len(cycles) != 2 || cycles[0].ID != "cycle-1" || !cycles[0].IsActive {
+		t.Errorf("GetCycles() = %+v, want cached cycles", cycles)
+	}
+}
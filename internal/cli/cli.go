@@ -0,0 +1,273 @@
+// Package cli implements headless subcommands for scripting linear-tui
+// from shells and git hooks without launching the TUI.
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// API is the subset of linearapi.Client used by the CLI subcommands.
+type API interface {
+	ListTeams(ctx context.Context) ([]linearapi.Team, error)
+	ListProjects(ctx context.Context, teamID string) ([]linearapi.Project, error)
+	ListUsers(ctx context.Context, teamID string) ([]linearapi.User, error)
+	GetCurrentUser(ctx context.Context) (linearapi.User, error)
+	ListWorkflowStates(ctx context.Context, teamID string) ([]linearapi.WorkflowState, error)
+	ListIssueLabels(ctx context.Context, teamID string) ([]linearapi.IssueLabel, error)
+	FetchIssues(ctx context.Context, params linearapi.FetchIssuesParams) ([]linearapi.Issue, error)
+	FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error)
+	CreateIssue(ctx context.Context, input linearapi.CreateIssueInput) (linearapi.Issue, error)
+	UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error)
+	CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error)
+}
+
+// Exit codes returned by Run.
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitUsage = 2
+)
+
+// usageError marks errors caused by invalid arguments.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// usageErrorf creates a usage error with a formatted message.
+func usageErrorf(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// IsCommand reports whether arg names a CLI subcommand.
+func IsCommand(arg string) bool {
+	switch arg {
+	case "issues", "issue", "comment", "webhook", "login", "help":
+		return true
+	default:
+		return false
+	}
+}
+
+// runner holds the streams and API client shared by subcommands.
+type runner struct {
+	api    API
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// Run executes the CLI subcommand described by args and returns an exit code.
+// args should not include the program name (e.g. ["issue", "show", "ENG-123"]).
+func Run(ctx context.Context, api API, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	r := &runner{api: api, stdin: stdin, stdout: stdout, stderr: stderr}
+
+	err := r.dispatch(ctx, args)
+	if err == nil {
+		return ExitOK
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	}
+
+	fmt.Fprintf(stderr, "Error: %v\n", err)
+	var uerr *usageError
+	if errors.As(err, &uerr) {
+		fmt.Fprintln(stderr)
+		writeUsage(stderr)
+		return ExitUsage
+	}
+	return ExitError
+}
+
+// dispatch routes args to the matching subcommand.
+func (r *runner) dispatch(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("missing command")
+	}
+
+	command := args[0]
+	if command == "login" {
+		return r.runLogin(ctx, args[1:])
+	}
+
+	var sub string
+	var rest []string
+	if len(args) > 1 {
+		sub = args[1]
+		rest = args[2:]
+	}
+
+	switch command {
+	case "help":
+		writeUsage(r.stdout)
+		return nil
+	case "issues":
+		if sub == "list" {
+			return r.runIssuesList(ctx, rest)
+		}
+	case "issue":
+		switch sub {
+		case "list":
+			return r.runIssuesList(ctx, rest)
+		case "show":
+			return r.runIssueShow(ctx, rest)
+		case "create":
+			return r.runIssueCreate(ctx, rest)
+		case "update":
+			return r.runIssueUpdate(ctx, rest)
+		}
+	case "comment":
+		if sub == "add" {
+			return r.runCommentAdd(ctx, rest)
+		}
+	case "webhook":
+		if sub == "send" {
+			return r.runWebhookSend(ctx, rest)
+		}
+	default:
+		return usageErrorf("unknown command %q", command)
+	}
+
+	if sub == "" {
+		return usageErrorf("missing subcommand for %q", command)
+	}
+	return usageErrorf("unknown subcommand %q for %q", sub, command)
+}
+
+// writeUsage prints the CLI usage summary.
+func writeUsage(w io.Writer) {
+	fmt.Fprint(w, `Usage:
+  linear-tui                         Launch the terminal UI
+  linear-tui issues list [flags]     List issues
+  linear-tui issue show <id>         Show an issue with comments
+  linear-tui issue create [flags]    Create an issue
+  linear-tui issue update <id>       Update an issue
+  linear-tui comment add <id>        Add a comment to an issue
+  linear-tui webhook send <file>...  Send recorded webhook payloads to a local receiver
+  linear-tui login                   Log in with OAuth in the browser
+
+List flags:
+  --team KEY         Team key, name, or ID
+  --project NAME     Project name or ID (requires --team)
+  --state NAME       Workflow state name, or type (backlog, unstarted, started, completed, canceled)
+  --search TEXT      Full-text search
+  --sort FIELD       updated, created, or priority (default updated)
+  --limit N          Maximum number of issues to print
+
+Create/update flags:
+  --team KEY         Team key, name, or ID (create only, required)
+  --title TEXT       Issue title (required for create)
+  --description TEXT Issue description ("-" reads stdin)
+  --state NAME       Workflow state name or type
+  --priority VALUE   0-4 or none, urgent, high, normal, low
+  --assignee USER    "me", email, name, or "none" to unassign
+  --project NAME     Project name or ID
+  --parent ID        Parent issue identifier, or "none" to remove (update only)
+  --labels LIST      Comma-separated label names (update only, empty clears)
+
+Comment flags:
+  --body TEXT        Comment body ("-" reads stdin)
+
+Webhook flags:
+  --url URL          Receiver URL (default http://127.0.0.1:8787)
+  --secret SECRET    Signing secret (default $LINEAR_WEBHOOK_SECRET)
+
+Login flags:
+  --profile NAME     Account profile to store the token for
+  --no-browser       Print the authorization URL without opening a browser
+
+Output flags (all commands):
+  --format FORMAT    table, json, or plain (default table)
+  --json             Shorthand for --format json
+`)
+}
+
+// newFlagSet creates a flag set that reports errors to stderr without exiting.
+func (r *runner) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(r.stderr)
+	return fs
+}
+
+// parseInterspersed parses flags that may appear before or after positional args.
+// The standard flag package stops at the first non-flag argument, which makes
+// `issue show ENG-123 --json` awkward, so positional args are collected here.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0, 1)
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, &usageError{msg: err.Error()}
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// singleIssueArg validates that exactly one issue identifier was provided.
+func singleIssueArg(command string, positional []string) (string, error) {
+	if len(positional) != 1 {
+		return "", usageErrorf("%s requires exactly one issue identifier", command)
+	}
+	id := strings.TrimSpace(positional[0])
+	if id == "" {
+		return "", usageErrorf("%s requires exactly one issue identifier", command)
+	}
+	return id, nil
+}
+
+// readTextArg returns value, reading stdin when value is "-".
+func (r *runner) readTextArg(value string) (string, error) {
+	if value != "-" {
+		return value, nil
+	}
+	if r.stdin == nil {
+		return "", fmt.Errorf("stdin is not available")
+	}
+	data, err := io.ReadAll(r.stdin)
+	if err != nil {
+		return "", fmt.Errorf("read stdin: %w", err)
+	}
+	return strings.TrimRight(string(data), "\n"), nil
+}
+
+// flagWasSet reports whether the named flag was explicitly provided.
+func flagWasSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// countSetFlags returns how many of the named flags were explicitly provided.
+func countSetFlags(fs *flag.FlagSet, names ...string) int {
+	count := 0
+	fs.Visit(func(f *flag.Flag) {
+		for _, name := range names {
+			if f.Name == name {
+				count++
+			}
+		}
+	})
+	return count
+}
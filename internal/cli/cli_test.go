@@ -0,0 +1,447 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/oauth"
+	"github.com/roeyazroel/linear-tui/internal/webhook"
+)
+
+// fakeAPI is an in-memory API implementation for CLI tests.
+type fakeAPI struct {
+	teams    []linearapi.Team
+	projects map[string][]linearapi.Project
+	users    map[string][]linearapi.User
+	me       linearapi.User
+	states   map[string][]linearapi.WorkflowState
+	labels   map[string][]linearapi.IssueLabel
+	issues   map[string]linearapi.Issue
+
+	fetchParams   []linearapi.FetchIssuesParams
+	fetchResult   []linearapi.Issue
+	createInputs  []linearapi.CreateIssueInput
+	updateInputs  []linearapi.UpdateIssueInput
+	commentInputs []linearapi.CreateCommentInput
+}
+
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{
+		teams: []linearapi.Team{{ID: "team-1", Key: "ENG", Name: "Engineering"}},
+		projects: map[string][]linearapi.Project{
+			"team-1": {{ID: "proj-1", Name: "Infra", TeamID: "team-1"}},
+		},
+		users: map[string][]linearapi.User{
+			"team-1": {{ID: "user-2", Name: "Jane Doe", DisplayName: "jane", Email: "jane@example.com"}},
+		},
+		me: linearapi.User{ID: "user-1", Name: "Me", IsMe: true},
+		states: map[string][]linearapi.WorkflowState{
+			"team-1": {
+				{ID: "state-todo", Name: "Todo", Type: "unstarted", Position: 1},
+				{ID: "state-review", Name: "In Review", Type: "started", Position: 3},
+				{ID: "state-progress", Name: "In Progress", Type: "started", Position: 2},
+				{ID: "state-done", Name: "Done", Type: "completed", Position: 4},
+			},
+		},
+		labels: map[string][]linearapi.IssueLabel{
+			"team-1": {{ID: "lbl-bug", Name: "Bug"}, {ID: "lbl-ui", Name: "UI"}},
+		},
+		issues: map[string]linearapi.Issue{
+			"ENG-1": {ID: "issue-1", Identifier: "ENG-1", Title: "Parent", TeamID: "team-1", State: "Todo"},
+			"ENG-2": {ID: "issue-2", Identifier: "ENG-2", Title: "Child", TeamID: "team-1", State: "Todo", Priority: 2},
+		},
+	}
+}
+
+func (f *fakeAPI) ListTeams(ctx context.Context) ([]linearapi.Team, error) {
+	return f.teams, nil
+}
+
+func (f *fakeAPI) ListProjects(ctx context.Context, teamID string) ([]linearapi.Project, error) {
+	return f.projects[teamID], nil
+}
+
+func (f *fakeAPI) ListUsers(ctx context.Context, teamID string) ([]linearapi.User, error) {
+	return f.users[teamID], nil
+}
+
+func (f *fakeAPI) GetCurrentUser(ctx context.Context) (linearapi.User, error) {
+	return f.me, nil
+}
+
+func (f *fakeAPI) ListWorkflowStates(ctx context.Context, teamID string) ([]linearapi.WorkflowState, error) {
+	return f.states[teamID], nil
+}
+
+func (f *fakeAPI) ListIssueLabels(ctx context.Context, teamID string) ([]linearapi.IssueLabel, error) {
+	return f.labels[teamID], nil
+}
+
+func (f *fakeAPI) FetchIssues(ctx context.Context, params linearapi.FetchIssuesParams) ([]linearapi.Issue, error) {
+	f.fetchParams = append(f.fetchParams, params)
+	if params.Limit > 0 && len(f.fetchResult) > params.Limit {
+		return f.fetchResult[:params.Limit], nil
+	}
+	return f.fetchResult, nil
+}
+
+func (f *fakeAPI) FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error) {
+	issue, ok := f.issues[id]
+	if !ok {
+		return linearapi.Issue{}, fmt.Errorf("fetch issue %s: not found", id)
+	}
+	return issue, nil
+}
+
+func (f *fakeAPI) CreateIssue(ctx context.Context, input linearapi.CreateIssueInput) (linearapi.Issue, error) {
+	f.createInputs = append(f.createInputs, input)
+	return linearapi.Issue{ID: "issue-new", Identifier: "ENG-9", Title: input.Title, State: "Todo", Priority: input.Priority}, nil
+}
+
+func (f *fakeAPI) UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+	f.updateInputs = append(f.updateInputs, input)
+	return linearapi.Issue{ID: input.ID, Identifier: "ENG-2", Title: "Child", State: "In Progress"}, nil
+}
+
+func (f *fakeAPI) CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error) {
+	f.commentInputs = append(f.commentInputs, input)
+	return linearapi.Comment{ID: "comment-1", Body: input.Body, IssueID: input.IssueID}, nil
+}
+
+// runCLI executes Run with captured output.
+func runCLI(t *testing.T, api API, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), api, args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestIsCommand(t *testing.T) {
+	for _, arg := range []string{"issues", "issue", "comment", "webhook", "login", "help"} {
+		if !IsCommand(arg) {
+			t.Errorf("IsCommand(%q) = false, want true", arg)
+		}
+	}
+	for _, arg := range []string{"", "--version", "tui", "issuez"} {
+		if IsCommand(arg) {
+			t.Errorf("IsCommand(%q) = true, want false", arg)
+		}
+	}
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "unknown subcommand", args: []string{"issue", "delete", "ENG-1"}},
+		{name: "missing subcommand", args: []string{"comment"}},
+		{name: "show without id", args: []string{"issue", "show"}},
+		{name: "create without team", args: []string{"issue", "create", "--title", "x"}},
+		{name: "invalid format", args: []string{"issues", "list", "--format", "xml"}},
+		{name: "update without fields", args: []string{"issue", "update", "ENG-1", "--json"}},
+		{name: "unknown flag", args: []string{"issues", "list", "--bogus"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := runCLI(t, newFakeAPI(), "", tt.args...)
+			if code != ExitUsage {
+				t.Errorf("exit code = %d, want %d (stderr=%q)", code, ExitUsage, stderr)
+			}
+			if !strings.Contains(stderr, "Usage:") {
+				t.Errorf("expected usage in stderr, got %q", stderr)
+			}
+		})
+	}
+}
+
+func TestRun_IssuesListResolvesFilters(t *testing.T) {
+	api := newFakeAPI()
+	api.fetchResult = []linearapi.Issue{
+		{Identifier: "ENG-1", Title: "First", State: "In Progress", Priority: 1},
+		{Identifier: "ENG-2", Title: "Second", State: "In Progress", Assignee: "Jane"},
+	}
+
+	code, stdout, stderr := runCLI(t, api, "", "issues", "list", "--team", "eng", "--state", "started", "--project", "infra", "--sort", "priority")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+
+	if len(api.fetchParams) != 1 {
+		t.Fatalf("expected 1 fetch, got %d", len(api.fetchParams))
+	}
+	params := api.fetchParams[0]
+	if params.TeamID != "team-1" || params.ProjectID != "proj-1" {
+		t.Errorf("unexpected team/project params: %+v", params)
+	}
+	if params.StateType != "started" || params.StateID != "" {
+		t.Errorf("expected state type filter, got %+v", params)
+	}
+	if params.OrderBy != "priority" {
+		t.Errorf("OrderBy = %q, want priority", params.OrderBy)
+	}
+
+	if !strings.Contains(stdout, "ID") || !strings.Contains(stdout, "ENG-1") || !strings.Contains(stdout, "Urgent") {
+		t.Errorf("unexpected table output: %q", stdout)
+	}
+}
+
+func TestRun_IssuesListStateNameAndLimit(t *testing.T) {
+	api := newFakeAPI()
+	api.fetchResult = []linearapi.Issue{{Identifier: "ENG-1"}, {Identifier: "ENG-2"}, {Identifier: "ENG-3"}}
+
+	code, stdout, stderr := runCLI(t, api, "", "issues", "list", "--team", "ENG", "--state", "in progress", "--limit", "2", "--format", "plain")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+	if api.fetchParams[0].StateID != "state-progress" {
+		t.Errorf("StateID = %q, want state-progress", api.fetchParams[0].StateID)
+	}
+	if api.fetchParams[0].Limit != 2 || api.fetchParams[0].First != 2 {
+		t.Errorf("Limit, First = %d, %d, want the limit passed to the fetch", api.fetchParams[0].Limit, api.fetchParams[0].First)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		t.Errorf("expected 2 plain lines, got %d: %q", len(lines), stdout)
+	}
+}
+
+func TestRun_IssuesListStateNameRequiresTeam(t *testing.T) {
+	code, _, _ := runCLI(t, newFakeAPI(), "", "issues", "list", "--state", "In Progress")
+	if code != ExitUsage {
+		t.Errorf("exit code = %d, want %d", code, ExitUsage)
+	}
+}
+
+func TestRun_IssueShowJSONWithTrailingFlag(t *testing.T) {
+	api := newFakeAPI()
+
+	code, stdout, stderr := runCLI(t, api, "", "issue", "show", "ENG-2", "--json")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+
+	var got issueJSON
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("invalid json output: %v\n%s", err, stdout)
+	}
+	if got.Identifier != "ENG-2" || got.PriorityLabel != "High" {
+		t.Errorf("unexpected issue json: %+v", got)
+	}
+}
+
+func TestRun_IssueShowNotFound(t *testing.T) {
+	code, _, stderr := runCLI(t, newFakeAPI(), "", "issue", "show", "ENG-404")
+	if code != ExitError {
+		t.Errorf("exit code = %d, want %d", code, ExitError)
+	}
+	if !strings.Contains(stderr, "not found") {
+		t.Errorf("expected not found error, got %q", stderr)
+	}
+}
+
+func TestRun_IssueCreate(t *testing.T) {
+	api := newFakeAPI()
+
+	code, stdout, stderr := runCLI(t, api, "Body from stdin\n",
+		"issue", "create",
+		"--team", "ENG",
+		"--title", "  New issue  ",
+		"--description", "-",
+		"--state", "started",
+		"--priority", "urgent",
+		"--assignee", "me",
+		"--parent", "ENG-1",
+		"--format", "plain",
+	)
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+	if len(api.createInputs) != 1 {
+		t.Fatalf("expected 1 create call, got %d", len(api.createInputs))
+	}
+
+	input := api.createInputs[0]
+	want := linearapi.CreateIssueInput{
+		TeamID:      "team-1",
+		Title:       "New issue",
+		Description: "Body from stdin",
+		StateID:     "state-progress",
+		AssigneeID:  "user-1",
+		Priority:    1,
+		ParentID:    "issue-1",
+	}
+	if input != want {
+		t.Errorf("CreateIssueInput = %+v, want %+v", input, want)
+	}
+	if !strings.HasPrefix(stdout, "ENG-9\t") {
+		t.Errorf("unexpected plain output: %q", stdout)
+	}
+}
+
+func TestRun_IssueUpdateOnlySetsProvidedFields(t *testing.T) {
+	api := newFakeAPI()
+
+	code, _, stderr := runCLI(t, api, "",
+		"issue", "update", "ENG-2",
+		"--state", "Done",
+		"--assignee", "none",
+		"--parent", "none",
+		"--labels", "bug, ui",
+	)
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+	if len(api.updateInputs) != 1 {
+		t.Fatalf("expected 1 update call, got %d", len(api.updateInputs))
+	}
+
+	input := api.updateInputs[0]
+	if input.ID != "issue-2" {
+		t.Errorf("ID = %q, want issue-2", input.ID)
+	}
+	if input.Title != nil || input.Description != nil || input.Priority != nil {
+		t.Errorf("unexpected fields set: %+v", input)
+	}
+	if input.StateID == nil || *input.StateID != "state-done" {
+		t.Errorf("StateID = %v, want state-done", input.StateID)
+	}
+	if input.AssigneeID == nil || *input.AssigneeID != "" {
+		t.Errorf("AssigneeID = %v, want empty (unassign)", input.AssigneeID)
+	}
+	if input.ParentID == nil || *input.ParentID != "" {
+		t.Errorf("ParentID = %v, want empty (remove parent)", input.ParentID)
+	}
+	if input.LabelIDs == nil || strings.Join(*input.LabelIDs, ",") != "lbl-bug,lbl-ui" {
+		t.Errorf("LabelIDs = %v, want [lbl-bug lbl-ui]", input.LabelIDs)
+	}
+}
+
+func TestRun_CommentAdd(t *testing.T) {
+	api := newFakeAPI()
+
+	code, stdout, stderr := runCLI(t, api, "", "comment", "add", "ENG-1", "--body", "Looks good")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+	if len(api.commentInputs) != 1 {
+		t.Fatalf("expected 1 comment call, got %d", len(api.commentInputs))
+	}
+	if api.commentInputs[0].IssueID != "issue-1" || api.commentInputs[0].Body != "Looks good" {
+		t.Errorf("unexpected comment input: %+v", api.commentInputs[0])
+	}
+	if !strings.Contains(stdout, "comment-1") || !strings.Contains(stdout, "ENG-1") {
+		t.Errorf("unexpected output: %q", stdout)
+	}
+}
+
+func TestRun_CommentAddRequiresBody(t *testing.T) {
+	code, _, _ := runCLI(t, newFakeAPI(), "", "comment", "add", "ENG-1")
+	if code != ExitUsage {
+		t.Errorf("exit code = %d, want %d", code, ExitUsage)
+	}
+}
+
+func TestRun_WebhookSend(t *testing.T) {
+	received := make(chan string, 2)
+	server := httptest.NewServer(webhook.NewHandler("secret", func(event webhook.Event) {
+		received <- event.Type
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "payload.json")
+	payload := `{"action":"update","type":"IssueLabel","data":{"id":"lbl-bug","name":"Defect"}}`
+	if err := os.WriteFile(path, []byte(payload), 0644); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+
+	code, stdout, stderr := runCLI(t, nil, "", "webhook", "send", path, "--url", server.URL, "--secret", "secret")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+	if len(received) != 1 || <-received != webhook.TypeIssueLabel {
+		t.Errorf("expected one IssueLabel event")
+	}
+	if !strings.Contains(stdout, "Sent") {
+		t.Errorf("unexpected output: %q", stdout)
+	}
+
+	code, _, stderr = runCLI(t, nil, "", "webhook", "send", path, "--url", server.URL, "--secret", "wrong")
+	if code != ExitError || !strings.Contains(stderr, "401") {
+		t.Errorf("wrong secret: exit code = %d, stderr=%q", code, stderr)
+	}
+
+	t.Setenv(config.WebhookSecretEnv, "")
+	if code, _, _ := runCLI(t, nil, "", "webhook", "send", path); code != ExitUsage {
+		t.Errorf("missing secret: exit code = %d, want %d", code, ExitUsage)
+	}
+}
+
+func TestRun_Login(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		target := query.Get("redirect_uri") + "?code=code-1&state=" + url.QueryEscape(query.Get("state"))
+		http.Redirect(w, r, target, http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"access-1","token_type":"Bearer","refresh_token":"refresh-1","expires_in":3600}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	settings := config.DefaultSettings()
+	settings.OAuth = &config.OAuthSettings{
+		ClientID:     "client-1",
+		RedirectURL:  "http://127.0.0.1:0/callback",
+		AuthorizeURL: server.URL + "/authorize",
+		TokenURL:     server.URL + "/token",
+	}
+	settings.Profiles = []config.Profile{{Name: "work", AuthMethod: config.AuthMethodOAuth}}
+	if err := config.SaveSettings(filepath.Join(home, ".linear-tui", "config.json"), settings); err != nil {
+		t.Fatalf("save settings: %v", err)
+	}
+
+	previous := openBrowser
+	openBrowser = func(authURL string) error {
+		resp, err := http.Get(authURL)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	defer func() { openBrowser = previous }()
+
+	code, stdout, stderr := runCLI(t, nil, "", "login", "--profile", "work")
+	if code != ExitOK {
+		t.Fatalf("exit code = %d, stderr=%q", code, stderr)
+	}
+	if !strings.Contains(stdout, "Logged in") || strings.Contains(stdout, "auth_method") {
+		t.Errorf("unexpected output: %q", stdout)
+	}
+
+	token, err := oauth.LoadToken(filepath.Join(home, ".linear-tui", "oauth_token-work.json"))
+	if err != nil || token.RefreshToken != "refresh-1" {
+		t.Errorf("stored token = %+v, %v; want refresh-1", token, err)
+	}
+
+	if code, _, _ := runCLI(t, nil, "", "login", "--profile", "missing"); code != ExitError {
+		t.Errorf("unknown profile: exit code = %d, want %d", code, ExitError)
+	}
+}
@@ -0,0 +1,353 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// maxIssuesPageSize is the largest page the Linear API returns.
+const maxIssuesPageSize = 250
+
+// runIssuesList handles `issues list`.
+func (r *runner) runIssuesList(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issues list")
+	var out outputFlags
+	out.register(fs)
+	team := fs.String("team", "", "team key, name, or ID")
+	project := fs.String("project", "", "project name or ID")
+	state := fs.String("state", "", "workflow state name or type")
+	search := fs.String("search", "", "full-text search")
+	sortBy := fs.String("sort", "updated", "updated, created, or priority")
+	limit := fs.Int("limit", 0, "maximum number of issues to print")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageErrorf("unexpected argument %q", positional[0])
+	}
+	format, err := out.resolve()
+	if err != nil {
+		return err
+	}
+	orderBy, err := parseSortField(*sortBy)
+	if err != nil {
+		return err
+	}
+	if *limit < 0 {
+		return usageErrorf("--limit must be zero or positive")
+	}
+
+	params := linearapi.FetchIssuesParams{
+		Search:  *search,
+		OrderBy: orderBy,
+	}
+
+	if *team != "" {
+		resolved, err := resolveTeam(ctx, r.api, *team)
+		if err != nil {
+			return err
+		}
+		params.TeamID = resolved.ID
+	}
+
+	if *project != "" {
+		if params.TeamID == "" {
+			return usageErrorf("--project requires --team")
+		}
+		resolved, err := resolveProject(ctx, r.api, params.TeamID, *project)
+		if err != nil {
+			return err
+		}
+		params.ProjectID = resolved.ID
+	}
+
+	if *state != "" {
+		var states []linearapi.WorkflowState
+		if params.TeamID != "" {
+			states, err = r.api.ListWorkflowStates(ctx, params.TeamID)
+			if err != nil {
+				return err
+			}
+		}
+		selector, err := matchState(states, *state)
+		if err != nil {
+			if params.TeamID == "" {
+				return usageErrorf("--state %q is not a state type; filtering by state name requires --team", *state)
+			}
+			return err
+		}
+		params.StateID = selector.StateID
+		params.StateType = selector.StateType
+	}
+
+	if *limit > 0 {
+		params.Limit = *limit
+		params.First = min(*limit, maxIssuesPageSize)
+	}
+
+	issues, err := r.api.FetchIssues(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return writeIssues(r.stdout, format, issues)
+}
+
+// runIssueShow handles `issue show <id>`.
+func (r *runner) runIssueShow(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issue show")
+	var out outputFlags
+	out.register(fs)
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	id, err := singleIssueArg("issue show", positional)
+	if err != nil {
+		return err
+	}
+	format, err := out.resolve()
+	if err != nil {
+		return err
+	}
+
+	issue, err := r.api.FetchIssueByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return writeIssueDetails(r.stdout, format, issue)
+}
+
+// runIssueCreate handles `issue create`.
+func (r *runner) runIssueCreate(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issue create")
+	var out outputFlags
+	out.register(fs)
+	team := fs.String("team", "", "team key, name, or ID (required)")
+	title := fs.String("title", "", "issue title (required)")
+	description := fs.String("description", "", "issue description (\"-\" reads stdin)")
+	state := fs.String("state", "", "workflow state name or type")
+	priority := fs.String("priority", "", "0-4 or none, urgent, high, normal, low")
+	assignee := fs.String("assignee", "", "\"me\", email, or name")
+	project := fs.String("project", "", "project name or ID")
+	parent := fs.String("parent", "", "parent issue identifier")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageErrorf("unexpected argument %q", positional[0])
+	}
+	format, err := out.resolve()
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(*team) == "" {
+		return usageErrorf("issue create requires --team")
+	}
+	if strings.TrimSpace(*title) == "" {
+		return usageErrorf("issue create requires --title")
+	}
+
+	resolvedTeam, err := resolveTeam(ctx, r.api, *team)
+	if err != nil {
+		return err
+	}
+
+	input := linearapi.CreateIssueInput{
+		TeamID: resolvedTeam.ID,
+		Title:  strings.TrimSpace(*title),
+	}
+
+	if *description != "" {
+		input.Description, err = r.readTextArg(*description)
+		if err != nil {
+			return err
+		}
+	}
+	if *priority != "" {
+		input.Priority, err = parsePriority(*priority)
+		if err != nil {
+			return err
+		}
+	}
+	if *state != "" {
+		input.StateID, err = resolveStateID(ctx, r.api, resolvedTeam.ID, *state)
+		if err != nil {
+			return err
+		}
+	}
+	if *assignee != "" {
+		input.AssigneeID, err = resolveAssigneeID(ctx, r.api, resolvedTeam.ID, *assignee)
+		if err != nil {
+			return err
+		}
+	}
+	if *project != "" {
+		resolvedProject, err := resolveProject(ctx, r.api, resolvedTeam.ID, *project)
+		if err != nil {
+			return err
+		}
+		input.ProjectID = resolvedProject.ID
+	}
+	if *parent != "" {
+		parentIssue, err := r.api.FetchIssueByID(ctx, *parent)
+		if err != nil {
+			return err
+		}
+		input.ParentID = parentIssue.ID
+	}
+
+	issue, err := r.api.CreateIssue(ctx, input)
+	if err != nil {
+		return err
+	}
+	return writeIssues(r.stdout, format, []linearapi.Issue{issue})
+}
+
+// runIssueUpdate handles `issue update <id>`.
+func (r *runner) runIssueUpdate(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issue update")
+	var out outputFlags
+	out.register(fs)
+	title := fs.String("title", "", "new issue title")
+	description := fs.String("description", "", "new description (\"-\" reads stdin)")
+	state := fs.String("state", "", "workflow state name or type")
+	priority := fs.String("priority", "", "0-4 or none, urgent, high, normal, low")
+	assignee := fs.String("assignee", "", "\"me\", email, name, or \"none\"")
+	parent := fs.String("parent", "", "parent issue identifier, or \"none\"")
+	labels := fs.String("labels", "", "comma-separated label names (empty clears)")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	id, err := singleIssueArg("issue update", positional)
+	if err != nil {
+		return err
+	}
+	format, err := out.resolve()
+	if err != nil {
+		return err
+	}
+
+	if fs.NFlag() == countSetFlags(fs, "format", "json") {
+		return usageErrorf("issue update requires at least one field to change")
+	}
+
+	issue, err := r.api.FetchIssueByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	input := linearapi.UpdateIssueInput{ID: issue.ID}
+
+	if flagWasSet(fs, "title") {
+		value := strings.TrimSpace(*title)
+		if value == "" {
+			return usageErrorf("--title cannot be empty")
+		}
+		input.Title = &value
+	}
+	if flagWasSet(fs, "description") {
+		value, err := r.readTextArg(*description)
+		if err != nil {
+			return err
+		}
+		input.Description = &value
+	}
+	if flagWasSet(fs, "priority") {
+		value, err := parsePriority(*priority)
+		if err != nil {
+			return err
+		}
+		input.Priority = &value
+	}
+	if flagWasSet(fs, "state") {
+		value, err := resolveStateID(ctx, r.api, issue.TeamID, *state)
+		if err != nil {
+			return err
+		}
+		input.StateID = &value
+	}
+	if flagWasSet(fs, "assignee") {
+		value, err := resolveAssigneeID(ctx, r.api, issue.TeamID, *assignee)
+		if err != nil {
+			return err
+		}
+		input.AssigneeID = &value
+	}
+	if flagWasSet(fs, "parent") {
+		value := ""
+		if !isNoneValue(*parent) && *parent != "" {
+			parentIssue, err := r.api.FetchIssueByID(ctx, *parent)
+			if err != nil {
+				return err
+			}
+			value = parentIssue.ID
+		}
+		input.ParentID = &value
+	}
+	if flagWasSet(fs, "labels") {
+		value, err := resolveLabelIDs(ctx, r.api, issue.TeamID, *labels)
+		if err != nil {
+			return err
+		}
+		input.LabelIDs = &value
+	}
+
+	updated, err := r.api.UpdateIssue(ctx, input)
+	if err != nil {
+		return err
+	}
+	return writeIssues(r.stdout, format, []linearapi.Issue{updated})
+}
+
+// runCommentAdd handles `comment add <id>`.
+func (r *runner) runCommentAdd(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("comment add")
+	var out outputFlags
+	out.register(fs)
+	body := fs.String("body", "", "comment body (\"-\" reads stdin)")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	id, err := singleIssueArg("comment add", positional)
+	if err != nil {
+		return err
+	}
+	format, err := out.resolve()
+	if err != nil {
+		return err
+	}
+
+	text, err := r.readTextArg(*body)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(text) == "" {
+		return usageErrorf("comment add requires --body")
+	}
+
+	issue, err := r.api.FetchIssueByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	comment, err := r.api.CreateComment(ctx, linearapi.CreateCommentInput{
+		IssueID: issue.ID,
+		Body:    text,
+	})
+	if err != nil {
+		return err
+	}
+	return writeComment(r.stdout, format, issue.Identifier, comment)
+}
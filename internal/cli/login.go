@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/oauth"
+)
+
+// loginTimeout bounds how long `login` waits for the user to authorize.
+const loginTimeout = 5 * time.Minute
+
+// openBrowser opens a URL in the default browser. Tests replace it.
+var openBrowser = func(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+// runLogin handles `login`, which authorizes linear-tui with OAuth and stores
+// the token for the selected profile.
+func (r *runner) runLogin(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("login")
+	profileName := fs.String("profile", "", "account profile to log in to")
+	noBrowser := fs.Bool("no-browser", false, "print the authorization URL without opening a browser")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usageErrorf("login takes no arguments")
+	}
+
+	settingsPath, err := config.ConfigFilePath()
+	if err != nil {
+		return err
+	}
+	settings, err := config.EnsureSettingsFile(settingsPath)
+	if err != nil {
+		return err
+	}
+	profile, err := config.ResolveProfile(settings, *profileName)
+	if err != nil {
+		return err
+	}
+	oauthCfg, err := config.OAuthConfig(settings)
+	if err != nil {
+		return err
+	}
+	tokenPath, err := config.OAuthTokenFilePath(profile.Name)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, loginTimeout)
+	defer cancel()
+	token, err := oauth.Login(ctx, oauthCfg, func(authURL string) error {
+		fmt.Fprintf(r.stdout, "Open this URL to authorize linear-tui:\n\n  %s\n\n", authURL)
+		if *noBrowser {
+			return nil
+		}
+		return openBrowser(authURL)
+	})
+	if err != nil {
+		return fmt.Errorf("login: %w", err)
+	}
+	if err := oauth.SaveToken(tokenPath, token); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(r.stdout, "Logged in. Token saved to %s\n", tokenPath)
+	if config.AuthMethodFor(settings, profile) != config.AuthMethodOAuth {
+		fmt.Fprintf(r.stdout, "Set \"auth_method\": \"oauth\" in %s to use it.\n", settingsPath)
+	}
+	return nil
+}
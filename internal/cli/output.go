@@ -0,0 +1,307 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// OutputFormat controls how command results are printed.
+type OutputFormat string
+
+// Supported output formats.
+const (
+	FormatTable OutputFormat = "table"
+	FormatJSON  OutputFormat = "json"
+	FormatPlain OutputFormat = "plain"
+)
+
+// outputFlags holds the shared --format/--json flags.
+type outputFlags struct {
+	format string
+	json   bool
+}
+
+// register adds output flags to the flag set.
+func (o *outputFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.format, "format", string(FormatTable), "output format: table, json, or plain")
+	fs.BoolVar(&o.json, "json", false, "shorthand for --format json")
+}
+
+// resolve returns the selected output format.
+func (o *outputFlags) resolve() (OutputFormat, error) {
+	if o.json {
+		return FormatJSON, nil
+	}
+	return parseOutputFormat(o.format)
+}
+
+// parseOutputFormat validates an output format name.
+func parseOutputFormat(value string) (OutputFormat, error) {
+	switch OutputFormat(strings.ToLower(strings.TrimSpace(value))) {
+	case FormatTable, "":
+		return FormatTable, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatPlain:
+		return FormatPlain, nil
+	default:
+		return "", usageErrorf("invalid format %q: must be table, json, or plain", value)
+	}
+}
+
+// priorityLabel returns the display label for a Linear priority value.
+func priorityLabel(priority int) string {
+	switch priority {
+	case 1:
+		return "Urgent"
+	case 2:
+		return "High"
+	case 3:
+		return "Normal"
+	case 4:
+		return "Low"
+	default:
+		return "-"
+	}
+}
+
+// issueJSON is the JSON representation of an issue.
+type issueJSON struct {
+	ID            string        `json:"id"`
+	Identifier    string        `json:"identifier"`
+	Title         string        `json:"title"`
+	Description   string        `json:"description,omitempty"`
+	State         string        `json:"state"`
+	StateID       string        `json:"state_id"`
+	Assignee      string        `json:"assignee,omitempty"`
+	AssigneeID    string        `json:"assignee_id,omitempty"`
+	Priority      int           `json:"priority"`
+	PriorityLabel string        `json:"priority_label"`
+	Labels        []string      `json:"labels"`
+	Parent        string        `json:"parent,omitempty"`
+	Children      []string      `json:"children,omitempty"`
+	TeamID        string        `json:"team_id"`
+	ProjectID     string        `json:"project_id,omitempty"`
+	URL           string        `json:"url"`
+	Archived      bool          `json:"archived"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	Comments      []commentJSON `json:"comments,omitempty"`
+}
+
+// commentJSON is the JSON representation of a comment.
+type commentJSON struct {
+	ID        string    `json:"id"`
+	IssueID   string    `json:"issue_id"`
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// toIssueJSON converts an issue to its JSON representation.
+func toIssueJSON(issue linearapi.Issue) issueJSON {
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	var children []string
+	for _, child := range issue.Children {
+		children = append(children, child.Identifier)
+	}
+
+	parent := ""
+	if issue.Parent != nil {
+		parent = issue.Parent.Identifier
+	}
+
+	var comments []commentJSON
+	for _, comment := range issue.Comments {
+		comments = append(comments, toCommentJSON(comment))
+	}
+
+	return issueJSON{
+		ID:            issue.ID,
+		Identifier:    issue.Identifier,
+		Title:         issue.Title,
+		Description:   issue.Description,
+		State:         issue.State,
+		StateID:       issue.StateID,
+		Assignee:      issue.Assignee,
+		AssigneeID:    issue.AssigneeID,
+		Priority:      issue.Priority,
+		PriorityLabel: priorityLabel(issue.Priority),
+		Labels:        labels,
+		Parent:        parent,
+		Children:      children,
+		TeamID:        issue.TeamID,
+		ProjectID:     issue.ProjectID,
+		URL:           issue.URL,
+		Archived:      issue.Archived,
+		CreatedAt:     issue.CreatedAt,
+		UpdatedAt:     issue.UpdatedAt,
+		Comments:      comments,
+	}
+}
+
+// toCommentJSON converts a comment to its JSON representation.
+func toCommentJSON(comment linearapi.Comment) commentJSON {
+	return commentJSON{
+		ID:        comment.ID,
+		IssueID:   comment.IssueID,
+		Author:    commentAuthor(comment),
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
+
+// commentAuthor returns the best display name for a comment author.
+func commentAuthor(comment linearapi.Comment) string {
+	if comment.Author.DisplayName != "" {
+		return comment.Author.DisplayName
+	}
+	if comment.Author.Name != "" {
+		return comment.Author.Name
+	}
+	return "Unknown"
+}
+
+// writeJSON writes v as indented JSON followed by a newline.
+func writeJSON(w io.Writer, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode json: %w", err)
+	}
+	data = append(data, '\n')
+	_, err = w.Write(data)
+	return err
+}
+
+// issueRowFields returns the columns printed for an issue row.
+func issueRowFields(issue linearapi.Issue) []string {
+	assignee := issue.Assignee
+	if assignee == "" {
+		assignee = "-"
+	}
+	return []string{
+		issue.Identifier,
+		issue.State,
+		priorityLabel(issue.Priority),
+		assignee,
+		issue.Title,
+	}
+}
+
+// writeIssues prints a list of issues in the requested format.
+func writeIssues(w io.Writer, format OutputFormat, issues []linearapi.Issue) error {
+	switch format {
+	case FormatJSON:
+		out := make([]issueJSON, 0, len(issues))
+		for _, issue := range issues {
+			out = append(out, toIssueJSON(issue))
+		}
+		return writeJSON(w, out)
+	case FormatPlain:
+		for _, issue := range issues {
+			if _, err := fmt.Fprintln(w, strings.Join(issueRowFields(issue), "\t")); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tSTATE\tPRIORITY\tASSIGNEE\tTITLE")
+		for _, issue := range issues {
+			fmt.Fprintln(tw, strings.Join(issueRowFields(issue), "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+// writeIssueDetails prints a single issue in the requested format.
+// Table format renders a detail block with description and comments;
+// plain format prints the same tab-separated row used by list.
+func writeIssueDetails(w io.Writer, format OutputFormat, issue linearapi.Issue) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, toIssueJSON(issue))
+	case FormatPlain:
+		return writeIssues(w, FormatPlain, []linearapi.Issue{issue})
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s  %s\n", issue.Identifier, issue.Title)
+
+	tw := tabwriter.NewWriter(&b, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(tw, "State:\t%s\n", issue.State)
+	assignee := issue.Assignee
+	if assignee == "" {
+		assignee = "Unassigned"
+	}
+	fmt.Fprintf(tw, "Assignee:\t%s\n", assignee)
+	fmt.Fprintf(tw, "Priority:\t%s\n", priorityLabel(issue.Priority))
+	if len(issue.Labels) > 0 {
+		names := make([]string, 0, len(issue.Labels))
+		for _, label := range issue.Labels {
+			names = append(names, label.Name)
+		}
+		fmt.Fprintf(tw, "Labels:\t%s\n", strings.Join(names, ", "))
+	}
+	if issue.Parent != nil {
+		fmt.Fprintf(tw, "Parent:\t%s %s\n", issue.Parent.Identifier, issue.Parent.Title)
+	}
+	if len(issue.Children) > 0 {
+		fmt.Fprintf(tw, "Sub-issues:\t%d\n", len(issue.Children))
+	}
+	if issue.URL != "" {
+		fmt.Fprintf(tw, "URL:\t%s\n", issue.URL)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if desc := strings.TrimSpace(issue.Description); desc != "" {
+		fmt.Fprintf(&b, "\n%s\n", desc)
+	}
+
+	if len(issue.Children) > 0 {
+		b.WriteString("\nSub-issues:\n")
+		for _, child := range issue.Children {
+			fmt.Fprintf(&b, "  %s [%s] %s\n", child.Identifier, child.State, child.Title)
+		}
+	}
+
+	if len(issue.Comments) > 0 {
+		fmt.Fprintf(&b, "\nComments (%d):\n", len(issue.Comments))
+		for _, comment := range issue.Comments {
+			fmt.Fprintf(&b, "\n%s (%s)\n", commentAuthor(comment), comment.CreatedAt.Local().Format("2006-01-02 15:04"))
+			fmt.Fprintf(&b, "%s\n", strings.TrimSpace(comment.Body))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeComment prints a created comment in the requested format.
+func writeComment(w io.Writer, format OutputFormat, issueIdentifier string, comment linearapi.Comment) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, toCommentJSON(comment))
+	case FormatPlain:
+		_, err := fmt.Fprintln(w, comment.ID)
+		return err
+	default:
+		_, err := fmt.Fprintf(w, "Added comment %s to %s\n", comment.ID, issueIdentifier)
+		return err
+	}
+}
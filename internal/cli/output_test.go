@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	for input, want := range map[string]OutputFormat{
+		"":      FormatTable,
+		"table": FormatTable,
+		"JSON":  FormatJSON,
+		"plain": FormatPlain,
+	} {
+		got, err := parseOutputFormat(input)
+		if err != nil {
+			t.Fatalf("parseOutputFormat(%q) error = %v", input, err)
+		}
+		if got != want {
+			t.Errorf("parseOutputFormat(%q) = %q, want %q", input, got, want)
+		}
+	}
+
+	if _, err := parseOutputFormat("yaml"); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestWriteIssues_JSON(t *testing.T) {
+	issues := []linearapi.Issue{{
+		ID:         "issue-1",
+		Identifier: "ENG-1",
+		Title:      "Fix login",
+		Priority:   3,
+		Labels:     []linearapi.IssueLabel{{ID: "lbl-1", Name: "Bug"}},
+		Parent:     &linearapi.IssueRef{Identifier: "ENG-0"},
+	}}
+
+	var buf bytes.Buffer
+	if err := writeIssues(&buf, FormatJSON, issues); err != nil {
+		t.Fatalf("writeIssues() error = %v", err)
+	}
+
+	var got []map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(got))
+	}
+	if got[0]["identifier"] != "ENG-1" || got[0]["priority_label"] != "Normal" || got[0]["parent"] != "ENG-0" {
+		t.Errorf("unexpected json: %v", got[0])
+	}
+	labels, ok := got[0]["labels"].([]interface{})
+	if !ok || len(labels) != 1 || labels[0] != "Bug" {
+		t.Errorf("unexpected labels: %v", got[0]["labels"])
+	}
+}
+
+func TestWriteIssues_EmptyJSONIsArray(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeIssues(&buf, FormatJSON, nil); err != nil {
+		t.Fatalf("writeIssues() error = %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("expected empty array, got %q", buf.String())
+	}
+}
+
+func TestWriteIssues_Plain(t *testing.T) {
+	issues := []linearapi.Issue{{Identifier: "ENG-1", State: "Todo", Priority: 0, Title: "Task"}}
+
+	var buf bytes.Buffer
+	if err := writeIssues(&buf, FormatPlain, issues); err != nil {
+		t.Fatalf("writeIssues() error = %v", err)
+	}
+	if buf.String() != "ENG-1\tTodo\t-\t-\tTask\n" {
+		t.Errorf("unexpected plain output: %q", buf.String())
+	}
+}
+
+func TestWriteIssueDetails_Table(t *testing.T) {
+	issue := linearapi.Issue{
+		Identifier:  "ENG-1",
+		Title:       "Fix login",
+		State:       "In Progress",
+		Priority:    2,
+		Description: "Steps to reproduce",
+		Children:    []linearapi.IssueChildRef{{Identifier: "ENG-2", Title: "Child", State: "Todo"}},
+		Comments: []linearapi.Comment{{
+			Body:      "On it",
+			CreatedAt: time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC),
+			Author:    linearapi.User{Name: "Jane"},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := writeIssueDetails(&buf, FormatTable, issue); err != nil {
+		t.Fatalf("writeIssueDetails() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"ENG-1  Fix login", "Unassigned", "High", "Steps to reproduce", "ENG-2 [Todo] Child", "Comments (1)", "Jane", "On it"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
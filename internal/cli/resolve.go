@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// stateTypes lists Linear workflow state types accepted by --state.
+var stateTypes = []string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}
+
+// resolveTeam finds a team by key, name, or ID (case-insensitive).
+func resolveTeam(ctx context.Context, api API, value string) (linearapi.Team, error) {
+	teams, err := api.ListTeams(ctx)
+	if err != nil {
+		return linearapi.Team{}, err
+	}
+	for _, team := range teams {
+		if team.ID == value || strings.EqualFold(team.Key, value) || strings.EqualFold(team.Name, value) {
+			return team, nil
+		}
+	}
+	return linearapi.Team{}, fmt.Errorf("team %q not found", value)
+}
+
+// resolveProject finds a project in a team by name or ID (case-insensitive).
+func resolveProject(ctx context.Context, api API, teamID, value string) (linearapi.Project, error) {
+	projects, err := api.ListProjects(ctx, teamID)
+	if err != nil {
+		return linearapi.Project{}, err
+	}
+	for _, project := range projects {
+		if project.ID == value || strings.EqualFold(project.Name, value) {
+			return project, nil
+		}
+	}
+	return linearapi.Project{}, fmt.Errorf("project %q not found", value)
+}
+
+// stateSelector is the result of resolving a --state value.
+// Exactly one of StateID or StateType is set.
+type stateSelector struct {
+	StateID   string
+	StateType string
+}
+
+// matchState resolves a state value against a team's workflow states.
+// Names match first; otherwise a state type (e.g. "started") is accepted.
+func matchState(states []linearapi.WorkflowState, value string) (stateSelector, error) {
+	for _, state := range states {
+		if state.ID == value || strings.EqualFold(state.Name, value) {
+			return stateSelector{StateID: state.ID}, nil
+		}
+	}
+	for _, stateType := range stateTypes {
+		if strings.EqualFold(stateType, value) {
+			return stateSelector{StateType: stateType}, nil
+		}
+	}
+
+	names := make([]string, 0, len(states))
+	for _, state := range states {
+		names = append(names, state.Name)
+	}
+	if len(names) == 0 {
+		return stateSelector{}, fmt.Errorf("state %q not found", value)
+	}
+	return stateSelector{}, fmt.Errorf("state %q not found (available: %s)", value, strings.Join(names, ", "))
+}
+
+// firstStateOfType returns the lowest-position state with the given type.
+func firstStateOfType(states []linearapi.WorkflowState, stateType string) (linearapi.WorkflowState, bool) {
+	matching := make([]linearapi.WorkflowState, 0)
+	for _, state := range states {
+		if state.Type == stateType {
+			matching = append(matching, state)
+		}
+	}
+	if len(matching) == 0 {
+		return linearapi.WorkflowState{}, false
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].Position < matching[j].Position
+	})
+	return matching[0], true
+}
+
+// resolveStateID resolves a --state value to a concrete state ID for mutations.
+func resolveStateID(ctx context.Context, api API, teamID, value string) (string, error) {
+	states, err := api.ListWorkflowStates(ctx, teamID)
+	if err != nil {
+		return "", err
+	}
+	selector, err := matchState(states, value)
+	if err != nil {
+		return "", err
+	}
+	if selector.StateID != "" {
+		return selector.StateID, nil
+	}
+	state, ok := firstStateOfType(states, selector.StateType)
+	if !ok {
+		return "", fmt.Errorf("team has no %s state", selector.StateType)
+	}
+	return state.ID, nil
+}
+
+// isNoneValue reports whether value requests clearing a field.
+func isNoneValue(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "none", "null", "-":
+		return true
+	default:
+		return false
+	}
+}
+
+// resolveAssigneeID resolves --assignee to a user ID.
+// "me" maps to the current user and "none" returns an empty ID (unassign).
+func resolveAssigneeID(ctx context.Context, api API, teamID, value string) (string, error) {
+	if isNoneValue(value) {
+		return "", nil
+	}
+	if strings.EqualFold(value, "me") {
+		user, err := api.GetCurrentUser(ctx)
+		if err != nil {
+			return "", err
+		}
+		return user.ID, nil
+	}
+
+	users, err := api.ListUsers(ctx, teamID)
+	if err != nil {
+		return "", err
+	}
+	for _, user := range users {
+		if user.ID == value ||
+			strings.EqualFold(user.Email, value) ||
+			strings.EqualFold(user.DisplayName, value) ||
+			strings.EqualFold(user.Name, value) {
+			return user.ID, nil
+		}
+	}
+	return "", fmt.Errorf("user %q not found", value)
+}
+
+// resolveLabelIDs resolves a comma-separated list of label names to IDs.
+// An empty list clears all labels.
+func resolveLabelIDs(ctx context.Context, api API, teamID, value string) ([]string, error) {
+	names := splitList(value)
+	if len(names) == 0 {
+		return []string{}, nil
+	}
+
+	labels, err := api.ListIssueLabels(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, label := range labels {
+			if label.ID == name || strings.EqualFold(label.Name, name) {
+				ids = append(ids, label.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("label %q not found", name)
+		}
+	}
+	return ids, nil
+}
+
+// splitList splits a comma-separated value and drops empty entries.
+func splitList(value string) []string {
+	parts := strings.Split(value, ",")
+	out := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// parsePriority parses a priority value from a number (0-4) or name.
+func parsePriority(value string) (int, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	switch normalized {
+	case "none", "no", "-":
+		return 0, nil
+	case "urgent":
+		return 1, nil
+	case "high":
+		return 2, nil
+	case "normal", "medium":
+		return 3, nil
+	case "low":
+		return 4, nil
+	}
+
+	n, err := strconv.Atoi(normalized)
+	if err != nil || n < 0 || n > 4 {
+		return 0, usageErrorf("invalid priority %q: must be 0-4 or none, urgent, high, normal, low", value)
+	}
+	return n, nil
+}
+
+// parseSortField maps a --sort value to a FetchIssuesParams OrderBy value.
+func parseSortField(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "updated", "updatedat":
+		return string(linearapi.OrderByUpdatedAt), nil
+	case "created", "createdat":
+		return string(linearapi.OrderByCreatedAt), nil
+	case "priority":
+		return "priority", nil
+	default:
+		return "", usageErrorf("invalid sort %q: must be updated, created, or priority", value)
+	}
+}
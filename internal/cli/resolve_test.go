@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestParsePriority(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{input: "0", want: 0},
+		{input: "none", want: 0},
+		{input: "Urgent", want: 1},
+		{input: "2", want: 2},
+		{input: "medium", want: 3},
+		{input: "normal", want: 3},
+		{input: "low", want: 4},
+		{input: "5", wantErr: true},
+		{input: "-1", wantErr: true},
+		{input: "critical", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parsePriority(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePriority(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parsePriority(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchState(t *testing.T) {
+	states := []linearapi.WorkflowState{
+		{ID: "state-1", Name: "Todo", Type: "unstarted"},
+		{ID: "state-2", Name: "Started", Type: "started"},
+	}
+
+	// Names win over types so custom states named like a type still resolve.
+	selector, err := matchState(states, "started")
+	if err != nil {
+		t.Fatalf("matchState() error = %v", err)
+	}
+	if selector.StateID != "state-2" {
+		t.Errorf("expected name match, got %+v", selector)
+	}
+
+	selector, err = matchState(states, "completed")
+	if err != nil {
+		t.Fatalf("matchState() error = %v", err)
+	}
+	if selector.StateType != "completed" || selector.StateID != "" {
+		t.Errorf("expected type match, got %+v", selector)
+	}
+
+	if _, err := matchState(states, "Blocked"); err == nil {
+		t.Error("expected error for unknown state")
+	}
+}
+
+func TestFirstStateOfType(t *testing.T) {
+	states := []linearapi.WorkflowState{
+		{ID: "review", Type: "started", Position: 3},
+		{ID: "progress", Type: "started", Position: 2},
+	}
+
+	state, ok := firstStateOfType(states, "started")
+	if !ok || state.ID != "progress" {
+		t.Errorf("firstStateOfType() = %+v, %v; want progress", state, ok)
+	}
+
+	if _, ok := firstStateOfType(states, "completed"); ok {
+		t.Error("expected no completed state")
+	}
+}
+
+func TestParseSortField(t *testing.T) {
+	tests := map[string]string{
+		"":         "updatedAt",
+		"updated":  "updatedAt",
+		"created":  "createdAt",
+		"priority": "priority",
+	}
+	for input, want := range tests {
+		got, err := parseSortField(input)
+		if err != nil {
+			t.Fatalf("parseSortField(%q) error = %v", input, err)
+		}
+		if got != want {
+			t.Errorf("parseSortField(%q) = %q, want %q", input, got, want)
+		}
+	}
+
+	if _, err := parseSortField("title"); err == nil {
+		t.Error("expected error for unsupported sort field")
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	got := splitList(" bug, ,UI ,")
+	if len(got) != 2 || got[0] != "bug" || got[1] != "UI" {
+		t.Errorf("splitList() = %#v", got)
+	}
+	if got := splitList(""); len(got) != 0 {
+		t.Errorf("splitList(\"\") = %#v, want empty", got)
+	}
+}
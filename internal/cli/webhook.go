@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/webhook"
+)
+
+// DefaultWebhookURL is where `webhook send` delivers payloads by default.
+const DefaultWebhookURL = "http://127.0.0.1:8787"
+
+// runWebhookSend handles `webhook send`, which signs recorded payload files and
+// POSTs them to a local receiver the way Linear would.
+func (r *runner) runWebhookSend(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("webhook send")
+	url := fs.String("url", DefaultWebhookURL, "receiver URL")
+	secret := fs.String("secret", os.Getenv(config.WebhookSecretEnv), "signing secret")
+
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return usageErrorf("webhook send requires at least one payload file")
+	}
+	if *secret == "" {
+		return usageErrorf("webhook send requires --secret or %s", config.WebhookSecretEnv)
+	}
+
+	for _, file := range files {
+		body, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read payload: %w", err)
+		}
+		if err := webhook.Send(ctx, *url, *secret, body); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		fmt.Fprintf(r.stdout, "Sent %s\n", file)
+	}
+	return nil
+}
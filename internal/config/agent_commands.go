@@ -0,0 +1,221 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Output modes of custom agent commands.
+const (
+	AgentOutputText  = "text"
+	AgentOutputJSONL = "jsonl"
+)
+
+// AgentCommand registers an agent CLI under agent_providers. Its key can be
+// used as agent_provider like the built-in providers.
+type AgentCommand struct {
+	// Key identifies the provider in agent_provider.
+	Key string `json:"key"`
+
+	// Name is the display name (defaults to the key).
+	Name string `json:"name,omitempty"`
+
+	// Binary is the executable name or path.
+	Binary string `json:"binary"`
+
+	// Args is the argv template. Arguments may contain {{prompt}},
+	// {{context}}, {{full_prompt}}, {{model}}, and {{workspace}}.
+	Args []string `json:"args"`
+
+	// Output is text (default) or jsonl.
+	Output string `json:"output,omitempty"`
+
+	// Events maps JSON-lines output onto agent events. The first mapping
+	// whose match conditions hold is used.
+	Events []AgentEventMapping `json:"events,omitempty"`
+}
+
+// AgentEventMapping converts one kind of JSON line into an agent event.
+// Field paths use jq syntax such as .item.text, .content[0].text, or
+// .content[].text.
+type AgentEventMapping struct {
+	// Match maps field paths to the values they must have.
+	Match map[string]string `json:"match,omitempty"`
+
+	// Type is the event type: system, user, assistant, assistant_delta,
+	// thinking, tool_call, or result.
+	Type string `json:"type"`
+
+	Text       string `json:"text,omitempty"`
+	Subtype    string `json:"subtype,omitempty"`
+	Model      string `json:"model,omitempty"`
+	SessionID  string `json:"session_id,omitempty"`
+	DurationMs string `json:"duration_ms,omitempty"`
+	IsError    string `json:"is_error,omitempty"`
+	ToolName   string `json:"tool_name,omitempty"`
+	ToolPath   string `json:"tool_path,omitempty"`
+}
+
+// DisplayName returns the name shown for the provider.
+func (c AgentCommand) DisplayName() string {
+	if name := strings.TrimSpace(c.Name); name != "" {
+		return name
+	}
+	return c.Key
+}
+
+// builtinAgentProviders lists the providers that need no configuration.
+var builtinAgentProviders = []string{"cursor", "claude", "codex", "gemini"}
+
+// agentCommandKeyPattern restricts keys to the lowercase form the settings
+// modal uses.
+var agentCommandKeyPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// FindAgentCommand returns the custom provider registered under key.
+func FindAgentCommand(commands []AgentCommand, key string) (AgentCommand, bool) {
+	normalized := strings.ToLower(strings.TrimSpace(key))
+	for _, command := range commands {
+		if command.Key == normalized {
+			return command, true
+		}
+	}
+	return AgentCommand{}, false
+}
+
+// validateAgentCommands validates custom agent providers.
+func validateAgentCommands(commands []AgentCommand, label string) error {
+	seen := make(map[string]bool, len(commands))
+	for i, command := range commands {
+		if !agentCommandKeyPattern.MatchString(command.Key) {
+			return fmt.Errorf("invalid %s[%d] key %q: use lowercase letters, digits, '-' or '_'", label, i, command.Key)
+		}
+		for _, builtin := range builtinAgentProviders {
+			if command.Key == builtin {
+				return fmt.Errorf("%s key %q is a built-in provider", label, command.Key)
+			}
+		}
+		if seen[command.Key] {
+			return fmt.Errorf("duplicate %s key %q", label, command.Key)
+		}
+		seen[command.Key] = true
+
+		if strings.TrimSpace(command.Binary) == "" {
+			return fmt.Errorf("%s %q: binary is required", label, command.Key)
+		}
+		switch command.Output {
+		case "", AgentOutputText:
+			if len(command.Events) > 0 {
+				return fmt.Errorf("%s %q: events require \"output\": \"jsonl\"", label, command.Key)
+			}
+		case AgentOutputJSONL:
+		default:
+			return fmt.Errorf("invalid %s %q output %q: must be text or jsonl", label, command.Key, command.Output)
+		}
+		for j, mapping := range command.Events {
+			if err := validateAgentEventMapping(mapping); err != nil {
+				return fmt.Errorf("%s %q events[%d]: %w", label, command.Key, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAgentEventMapping checks the event type and the field path syntax.
+func validateAgentEventMapping(mapping AgentEventMapping) error {
+	switch mapping.Type {
+	case "system", "user", "assistant", "assistant_delta", "thinking", "tool_call", "result":
+	default:
+		return fmt.Errorf("invalid type %q", mapping.Type)
+	}
+
+	paths := []string{mapping.Text, mapping.Subtype, mapping.Model, mapping.SessionID,
+		mapping.DurationMs, mapping.IsError, mapping.ToolName, mapping.ToolPath}
+	for path := range mapping.Match {
+		paths = append(paths, path)
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, err := ParseAgentFieldPath(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AgentFieldStep is one step of an event mapping field path: an object key,
+// an array index, or every array element.
+type AgentFieldStep struct {
+	Key   string
+	Index int
+	All   bool
+}
+
+// ParseAgentFieldPath parses the subset of jq paths supported in event
+// mappings: ., .key, .key.nested, .key[N], and .key[].
+func ParseAgentFieldPath(path string) ([]AgentFieldStep, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, fmt.Errorf("invalid field path %q: must start with '.'", path)
+	}
+
+	var steps []AgentFieldStep
+	rest := path
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				if rest == "" && len(steps) == 0 {
+					return steps, nil
+				}
+				if rest != "" && rest[0] == '[' {
+					continue
+				}
+				return nil, fmt.Errorf("invalid field path %q: empty key", path)
+			}
+			steps = append(steps, AgentFieldStep{Key: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid field path %q: missing ']'", path)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+			if inner == "" {
+				steps = append(steps, AgentFieldStep{All: true})
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid field path %q: bad index %q", path, inner)
+			}
+			steps = append(steps, AgentFieldStep{Index: index})
+		default:
+			return nil, fmt.Errorf("invalid field path %q", path)
+		}
+	}
+	return steps, nil
+}
+
+// validateAgentProvider validates that provider is built in or registered
+// under agent_providers.
+func validateAgentProvider(provider string, commands []AgentCommand, label string) error {
+	names := append([]string{}, builtinAgentProviders...)
+	for _, command := range commands {
+		names = append(names, command.Key)
+	}
+	for _, name := range names {
+		if provider == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %s value %q: must be one of %s", label, provider, strings.Join(names, ", "))
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoadSettingsAgentProviders verifies custom providers load and can be selected.
+func TestLoadSettingsAgentProviders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{
+  "agent_provider": "wrapper",
+  "agent_providers": [
+    {
+      "key": "wrapper",
+      "name": "Team agent",
+      "binary": "team-agent",
+      "args": ["exec", "--json", "{{full_prompt}}"],
+      "output": "jsonl",
+      "events": [{"match": {".type": "item.completed"}, "type": "assistant", "text": ".item.text"}]
+    }
+  ]
+}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("write settings: %v", err)
+	}
+
+	settings, err := LoadSettings(path)
+	if err != nil {
+		t.Fatalf("LoadSettings() error: %v", err)
+	}
+	cfg, err := ConfigFromSettings("test-key", settings)
+	if err != nil {
+		t.Fatalf("ConfigFromSettings() error: %v", err)
+	}
+	if cfg.AgentProvider != "wrapper" || len(cfg.AgentCommands) != 1 {
+		t.Fatalf("agent provider = %q with %d commands, want wrapper with 1", cfg.AgentProvider, len(cfg.AgentCommands))
+	}
+	command, ok := FindAgentCommand(cfg.AgentCommands, " Wrapper ")
+	if !ok || command.DisplayName() != "Team agent" || command.Events[0].Text != ".item.text" {
+		t.Errorf("FindAgentCommand() = %+v, %v", command, ok)
+	}
+	if got := SettingsFromConfig(cfg); len(got.AgentProviders) != 1 {
+		t.Errorf("SettingsFromConfig() agent_providers = %+v, want round-trip", got.AgentProviders)
+	}
+}
+
+// TestValidateAgentCommands checks invalid custom providers are rejected.
+func TestValidateAgentCommands(t *testing.T) {
+	valid := AgentCommand{Key: "aider", Binary: "aider", Args: []string{"--message", "{{prompt}}"}}
+
+	tests := []struct {
+		name    string
+		modify  func(*AgentCommand)
+		wantErr string
+	}{
+		{name: "uppercase_key", modify: func(c *AgentCommand) { c.Key = "Aider" }, wantErr: "key"},
+		{name: "builtin_key", modify: func(c *AgentCommand) { c.Key = "claude" }, wantErr: "built-in"},
+		{name: "missing_binary", modify: func(c *AgentCommand) { c.Binary = " " }, wantErr: "binary"},
+		{name: "invalid_output", modify: func(c *AgentCommand) { c.Output = "xml" }, wantErr: "output"},
+		{name: "events_need_jsonl", modify: func(c *AgentCommand) {
+			c.Events = []AgentEventMapping{{Type: "assistant", Text: ".text"}}
+		}, wantErr: "jsonl"},
+		{name: "invalid_event_type", modify: func(c *AgentCommand) {
+			c.Output = AgentOutputJSONL
+			c.Events = []AgentEventMapping{{Type: "message", Text: ".text"}}
+		}, wantErr: "type"},
+		{name: "invalid_path", modify: func(c *AgentCommand) {
+			c.Output = AgentOutputJSONL
+			c.Events = []AgentEventMapping{{Type: "assistant", Match: map[string]string{"type": "x"}}}
+		}, wantErr: "field path"},
+		{name: "invalid_path_syntax", modify: func(c *AgentCommand) {
+			c.Output = AgentOutputJSONL
+			c.Events = []AgentEventMapping{{Type: "assistant", Text: ".item..text"}}
+		}, wantErr: "field path"},
+		{name: "invalid_path_index", modify: func(c *AgentCommand) {
+			c.Output = AgentOutputJSONL
+			c.Events = []AgentEventMapping{{Type: "assistant", Text: ".content[x]"}}
+		}, wantErr: "bad index"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := valid
+			tt.modify(&command)
+			err := validateAgentCommands([]AgentCommand{command}, "agent_providers")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("validateAgentCommands() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := validateAgentCommands([]AgentCommand{valid, valid}, "agent_providers"); err == nil {
+		t.Error("validateAgentCommands() expected error for duplicate keys")
+	}
+
+	settings := DefaultSettings()
+	settings.AgentProvider = "aider"
+	if _, err := ConfigFromSettings("test-key", settings); err == nil {
+		t.Error("ConfigFromSettings() expected error for unregistered agent_provider")
+	}
+	settings.AgentProviders = []AgentCommand{valid}
+	if _, err := ConfigFromSettings("test-key", settings); err != nil {
+		t.Errorf("ConfigFromSettings() error: %v", err)
+	}
+}
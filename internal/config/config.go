@@ -17,6 +17,7 @@ const (
 	CacheTTLEnv       = "LINEAR_CACHE_TTL"
 	LogFileEnv        = "LINEAR_LOG_FILE"
 	LogLevelEnv       = "LINEAR_LOG_LEVEL"
+	WebhookSecretEnv  = "LINEAR_WEBHOOK_SECRET"
 )
 
 // Default configuration values.
@@ -33,8 +34,13 @@ const (
 	DensityComfortable   = "comfortable"
 	DensityCompact       = "compact"
 	DefaultDensity       = DensityComfortable
+	IssuesLayoutTable    = "table"
+	IssuesLayoutBoard    = "board"
+	DefaultIssuesLayout  = IssuesLayoutTable
 	DefaultAgentProvider = "cursor"
 	DefaultAgentSandbox  = "enabled"
+	DefaultPollInterval  = 2 * time.Minute
+	MinPollInterval      = 10 * time.Second
 )
 
 // getDefaultLogFile returns the default log file path: $HOME/.linear-tui/app.log
@@ -52,6 +58,26 @@ type Config struct {
 	// LinearAPIKey is the API key for authenticating with Linear.
 	LinearAPIKey string
 
+	// APIKeySource is where LinearAPIKey was read from; it is read again when
+	// the API rejects the key.
+	APIKeySource KeySource
+
+	// APIKeyCommand is a credential helper command that prints the API key.
+	APIKeyCommand string
+
+	// APIKeyFile is the path of a 0600 file containing the API key.
+	APIKeyFile string
+
+	// AuthMethod is the configured authentication method (api_key or oauth).
+	AuthMethod string
+
+	// OAuth configures the OAuth application used by `linear-tui login`.
+	OAuth *OAuthSettings
+
+	// TokenSource supplies OAuth access tokens when the active account logs in
+	// with OAuth (nil for API keys).
+	TokenSource TokenSource
+
 	// APIEndpoint is the Linear GraphQL API endpoint (useful for testing).
 	APIEndpoint string
 
@@ -76,9 +102,16 @@ type Config struct {
 	// Density controls the UI spacing density.
 	Density string
 
-	// AgentProvider selects the agent CLI provider (cursor or claude).
+	// IssuesLayout selects how issues are shown (table or board).
+	IssuesLayout string
+
+	// AgentProvider selects the agent CLI provider (cursor, claude, codex,
+	// gemini, or the key of a custom provider).
 	AgentProvider string
 
+	// AgentCommands are the custom agent providers from agent_providers.
+	AgentCommands []AgentCommand
+
 	// AgentSandbox configures sandboxing for the agent CLI (enabled or disabled).
 	AgentSandbox string
 
@@ -87,6 +120,49 @@ type Config struct {
 
 	// AgentWorkspace is the default workspace path for agent runs.
 	AgentWorkspace string
+
+	// WebhookAddr is the listen address of the webhook receiver (empty to disable).
+	WebhookAddr string
+
+	// WebhookSecret is the signing secret used to verify webhook deliveries.
+	WebhookSecret string
+
+	// PollInterval is how often issues are refreshed when no webhook receiver
+	// is running (0 to disable).
+	PollInterval time.Duration
+
+	// Profile is the active account profile (empty when profiles are not used).
+	Profile string
+
+	// Profiles are the configured account profiles.
+	Profiles []Profile
+
+	// DefaultProfile is the profile used when --profile is not given.
+	DefaultProfile string
+
+	// ProfileAPIEndpoint overrides APIEndpoint for the active profile.
+	ProfileAPIEndpoint string
+
+	// DefaultTeam is the team (key, name, or ID) selected when the profile loads.
+	DefaultTeam string
+}
+
+// Endpoint returns the API endpoint of the active profile, or APIEndpoint
+// when the profile does not override it.
+func (c Config) Endpoint() string {
+	if c.ProfileAPIEndpoint != "" {
+		return c.ProfileAPIEndpoint
+	}
+	return c.APIEndpoint
+}
+
+// AccountKey identifies the credentials of the active account. OAuth access
+// tokens rotate, so OAuth accounts are identified by their client ID instead.
+func (c Config) AccountKey() string {
+	if c.TokenSource != nil && c.OAuth != nil {
+		return "oauth:" + c.OAuth.ClientID
+	}
+	return c.LinearAPIKey
 }
 
 // LoadFromEnv loads configuration from environment variables.
@@ -108,10 +184,12 @@ func LoadFromEnv() (Config, error) {
 		LogLevel:       DefaultLogLevel,
 		Theme:          DefaultTheme,
 		Density:        DefaultDensity,
+		IssuesLayout:   DefaultIssuesLayout,
 		AgentProvider:  DefaultAgentProvider,
 		AgentSandbox:   DefaultAgentSandbox,
 		AgentModel:     "",
 		AgentWorkspace: "",
+		PollInterval:   DefaultPollInterval,
 	}
 
 	// Parse optional API endpoint override.
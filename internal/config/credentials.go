@@ -0,0 +1,168 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// keyCommandTimeout bounds how long an api_key_command may run. Helpers such
+// as password managers may wait for the user to unlock them.
+const keyCommandTimeout = time.Minute
+
+// KeySource describes where the Linear API key is read from: the output of a
+// credential helper command, a file, or an environment variable. Command takes
+// precedence over File, and File over Env.
+type KeySource struct {
+	// Env is the environment variable holding the key.
+	Env string
+
+	// Command is a shell command that prints the key (e.g. "pass show linear").
+	Command string
+
+	// File is the path of a file containing the key. It must not be readable
+	// by other users.
+	File string
+}
+
+// Refreshable reports whether the key can change while the app runs, in which
+// case it is fetched again when the API rejects it.
+func (s KeySource) Refreshable() bool {
+	return s.Command != "" || s.File != ""
+}
+
+// String describes the key source for error messages.
+func (s KeySource) String() string {
+	switch {
+	case s.Command != "":
+		return fmt.Sprintf("api_key_command %q", s.Command)
+	case s.File != "":
+		return fmt.Sprintf("api_key_file %s", s.File)
+	default:
+		return s.Env + " environment variable"
+	}
+}
+
+// Resolve fetches the API key. Commands and files return their first
+// non-empty line, so helpers that print extra metadata lines work as is.
+func (s KeySource) Resolve(ctx context.Context) (string, error) {
+	switch {
+	case s.Command != "":
+		return runKeyCommand(ctx, s.Command)
+	case s.File != "":
+		return readKeyFile(s.File)
+	default:
+		key := os.Getenv(s.Env)
+		if key == "" {
+			return "", &MissingAPIKeyError{Env: s.Env}
+		}
+		return key, nil
+	}
+}
+
+// RefreshFunc returns a function that fetches the key again, for refreshing
+// it after a 401 response. It returns nil when the key cannot change.
+func (s KeySource) RefreshFunc() func(context.Context) (string, error) {
+	if !s.Refreshable() {
+		return nil
+	}
+	return s.Resolve
+}
+
+// runKeyCommand runs a credential helper through the shell and returns the
+// key it prints.
+func runKeyCommand(ctx context.Context, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, keyCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("api_key_command %q timed out after %s", command, keyCommandTimeout)
+		}
+		if detail := firstLine(stderr.String()); detail != "" {
+			return "", fmt.Errorf("api_key_command %q failed: %w: %s", command, err, detail)
+		}
+		return "", fmt.Errorf("api_key_command %q failed: %w", command, err)
+	}
+
+	key := firstLine(stdout.String())
+	if key == "" {
+		return "", fmt.Errorf("api_key_command %q printed no API key", command)
+	}
+	return key, nil
+}
+
+// readKeyFile reads the key from a file that only its owner can access.
+func readKeyFile(path string) (string, error) {
+	path, err := expandHome(path)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("read api_key_file: %w", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return "", fmt.Errorf("api_key_file %s is accessible by other users (mode %04o); run chmod 600 %s", path, info.Mode().Perm(), path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read api_key_file: %w", err)
+	}
+	key := firstLine(string(data))
+	if key == "" {
+		return "", fmt.Errorf("api_key_file %s is empty", path)
+	}
+	return key, nil
+}
+
+// expandHome expands a leading "~/" to the user's home directory.
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, strings.TrimPrefix(path, "~")), nil
+}
+
+// firstLine returns the first non-empty line of s with surrounding whitespace
+// removed.
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// validateKeySource rejects settings that configure both a command and a file.
+func validateKeySource(command, file string, label string) error {
+	if strings.TrimSpace(command) != "" && strings.TrimSpace(file) != "" {
+		return fmt.Errorf("%s: set only one of api_key_command and api_key_file", label)
+	}
+
+	return nil
+}
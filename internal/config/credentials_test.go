@@ -0,0 +1,154 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestKeySourceCommand verifies the first line printed by a helper is the key.
+func TestKeySourceCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("helper commands run through sh")
+	}
+
+	source := KeySource{Env: LinearAPIKeyEnv, Command: "printf 'lin_api_123\\nlogin: me\\n'"}
+	key, err := source.Resolve(context.Background())
+	if err != nil {
+		t.Fatalf("Resolve() error: %v", err)
+	}
+	if key != "lin_api_123" {
+		t.Errorf("Resolve() = %q, want lin_api_123", key)
+	}
+	if !source.Refreshable() || source.RefreshFunc() == nil {
+		t.Error("command source should be refreshable")
+	}
+}
+
+// TestKeySourceCommandErrors verifies helper failures are reported with stderr.
+func TestKeySourceCommandErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("helper commands run through sh")
+	}
+
+	_, err := KeySource{Command: "echo 'vault is locked' >&2; exit 3"}.Resolve(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "exit status 3") || !strings.Contains(err.Error(), "vault is locked") {
+		t.Errorf("Resolve() error = %v, want exit status and stderr", err)
+	}
+
+	_, err = KeySource{Command: "true"}.Resolve(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "printed no API key") {
+		t.Errorf("Resolve() error = %v, want empty output error", err)
+	}
+}
+
+// TestKeySourceFile verifies keys are read from files only their owner can access.
+func TestKeySourceFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not checked on windows")
+	}
+
+	path := filepath.Join(t.TempDir(), "linear-key")
+	if err := os.WriteFile(path, []byte("lin_api_file\n"), 0600); err != nil {
+		t.Fatalf("write key file: %v", err)
+	}
+
+	key, err := KeySource{File: path}.Resolve(context.Background())
+	if err != nil {
+		t.Fatalf("Resolve() error: %v", err)
+	}
+	if key != "lin_api_file" {
+		t.Errorf("Resolve() = %q, want lin_api_file", key)
+	}
+
+	if err := os.Chmod(path, 0644); err != nil {
+		t.Fatalf("chmod key file: %v", err)
+	}
+	_, err = KeySource{File: path}.Resolve(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "chmod 600") {
+		t.Errorf("Resolve() error = %v, want permission error", err)
+	}
+
+	if _, err := (KeySource{File: filepath.Join(t.TempDir(), "missing")}).Resolve(context.Background()); err == nil {
+		t.Error("Resolve() expected error for missing file")
+	}
+}
+
+// TestKeySourceEnv verifies the environment variable fallback.
+func TestKeySourceEnv(t *testing.T) {
+	t.Setenv("LINEAR_TEST_KEY", "")
+	source := KeySource{Env: "LINEAR_TEST_KEY"}
+
+	_, err := source.Resolve(context.Background())
+	var missing *MissingAPIKeyError
+	if !errors.As(err, &missing) || missing.Env != "LINEAR_TEST_KEY" {
+		t.Errorf("Resolve() error = %v, want missing LINEAR_TEST_KEY", err)
+	}
+	if source.Refreshable() || source.RefreshFunc() != nil {
+		t.Error("environment source should not be refreshable")
+	}
+
+	t.Setenv("LINEAR_TEST_KEY", "lin_api_env")
+	if key, err := source.Resolve(context.Background()); err != nil || key != "lin_api_env" {
+		t.Errorf("Resolve() = %q, %v; want lin_api_env", key, err)
+	}
+}
+
+// TestConfigForProfileKeyCommand verifies settings key helpers are used at startup.
+func TestConfigForProfileKeyCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("helper commands run through sh")
+	}
+
+	settings := DefaultSettings()
+	settings.APIKeyCommand = "echo global-key"
+	settings.Profiles = []Profile{{Name: "oss", APIKeyCommand: "echo oss-key"}, {Name: "plain"}}
+
+	cfg, err := ConfigForProfile(settings, "")
+	if err != nil {
+		t.Fatalf("ConfigForProfile() error: %v", err)
+	}
+	if cfg.LinearAPIKey != "global-key" || cfg.APIKeySource.Command != "echo global-key" {
+		t.Errorf("config key = %q from %s, want global-key", cfg.LinearAPIKey, cfg.APIKeySource)
+	}
+
+	cfg, err = ConfigForProfile(settings, "oss")
+	if err != nil {
+		t.Fatalf("ConfigForProfile(oss) error: %v", err)
+	}
+	if cfg.LinearAPIKey != "oss-key" || cfg.APIKeySource.Command != "echo oss-key" {
+		t.Errorf("oss key = %q from %s, want oss-key", cfg.LinearAPIKey, cfg.APIKeySource)
+	}
+
+	// A profile without its own key source uses the global helper
+	cfg, err = ConfigForProfile(settings, "plain")
+	if err != nil {
+		t.Fatalf("ConfigForProfile(plain) error: %v", err)
+	}
+	if cfg.LinearAPIKey != "global-key" {
+		t.Errorf("plain key = %q, want global-key", cfg.LinearAPIKey)
+	}
+	if got := SettingsFromConfig(cfg); got.APIKeyCommand != "echo global-key" {
+		t.Errorf("SettingsFromConfig() api_key_command = %q", got.APIKeyCommand)
+	}
+}
+
+// TestConfigFromSettingsRejectsTwoKeySources verifies command and file are exclusive.
+func TestConfigFromSettingsRejectsTwoKeySources(t *testing.T) {
+	settings := DefaultSettings()
+	settings.APIKeyCommand = "pass show linear"
+	settings.APIKeyFile = "~/.linear-key"
+	if _, err := ConfigFromSettings("test-key", settings); err == nil {
+		t.Error("ConfigFromSettings() expected error with both api_key_command and api_key_file")
+	}
+
+	settings = DefaultSettings()
+	settings.Profiles = []Profile{{Name: "work", APIKeyCommand: "pass show linear", APIKeyFile: "~/.linear-key"}}
+	if _, err := ConfigFromSettings("test-key", settings); err == nil {
+		t.Error("ConfigFromSettings() expected error for profile with two key sources")
+	}
+}
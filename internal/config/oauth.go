@@ -0,0 +1,128 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/oauth"
+)
+
+// Authentication methods.
+const (
+	AuthMethodAPIKey  = "api_key"
+	AuthMethodOAuth   = "oauth"
+	DefaultAuthMethod = AuthMethodAPIKey
+)
+
+// OAuthClientSecretEnv optionally holds the OAuth application's client secret.
+// PKCE does not require one.
+const OAuthClientSecretEnv = "LINEAR_OAUTH_CLIENT_SECRET"
+
+// OAuthSettings configures the OAuth application used by `linear-tui login`.
+type OAuthSettings struct {
+	ClientID     string `json:"client_id"`
+	RedirectURL  string `json:"redirect_url,omitempty"`
+	Scopes       string `json:"scopes,omitempty"`
+	AuthorizeURL string `json:"authorize_url,omitempty"`
+	TokenURL     string `json:"token_url,omitempty"`
+}
+
+// TokenSource supplies expiring access tokens (see oauth.Source).
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+	Refresh(ctx context.Context, stale string) (string, error)
+}
+
+// OAuthConfig returns the OAuth client configuration from settings, applying
+// Linear's defaults.
+func OAuthConfig(settings Settings) (oauth.Config, error) {
+	if settings.OAuth == nil || strings.TrimSpace(settings.OAuth.ClientID) == "" {
+		return oauth.Config{}, fmt.Errorf("oauth.client_id is not set in the settings file")
+	}
+
+	cfg := oauth.Config{
+		ClientID:     strings.TrimSpace(settings.OAuth.ClientID),
+		ClientSecret: os.Getenv(OAuthClientSecretEnv),
+		AuthorizeURL: oauth.DefaultAuthorizeURL,
+		TokenURL:     oauth.DefaultTokenURL,
+		RedirectURL:  oauth.DefaultRedirectURL,
+		Scopes:       oauth.DefaultScopes,
+	}
+	if value := strings.TrimSpace(settings.OAuth.AuthorizeURL); value != "" {
+		cfg.AuthorizeURL = value
+	}
+	if value := strings.TrimSpace(settings.OAuth.TokenURL); value != "" {
+		cfg.TokenURL = value
+	}
+	if value := strings.TrimSpace(settings.OAuth.RedirectURL); value != "" {
+		cfg.RedirectURL = value
+	}
+	if value := strings.TrimSpace(settings.OAuth.Scopes); value != "" {
+		cfg.Scopes = value
+	}
+	return cfg, nil
+}
+
+// OAuthTokenFilePath returns the path of the stored OAuth token of a profile.
+func OAuthTokenFilePath(profile string) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+
+	return ProfileFilePath(filepath.Join(homeDir, ".linear-tui", "oauth_token.json"), profile), nil
+}
+
+// AuthMethodFor returns the authentication method of profile, falling back to
+// the global auth_method.
+func AuthMethodFor(settings Settings, profile Profile) string {
+	if method := strings.TrimSpace(profile.AuthMethod); method != "" {
+		return method
+	}
+	if method := strings.TrimSpace(settings.AuthMethod); method != "" {
+		return method
+	}
+	return DefaultAuthMethod
+}
+
+// configForOAuth builds runtime configuration for a profile that logs in with
+// OAuth, using the token stored by `linear-tui login`.
+func configForOAuth(settings Settings, profile Profile) (Config, error) {
+	oauthCfg, err := OAuthConfig(settings)
+	if err != nil {
+		return Config{}, err
+	}
+	tokenPath, err := OAuthTokenFilePath(profile.Name)
+	if err != nil {
+		return Config{}, err
+	}
+	source, err := oauth.OpenSource(oauthCfg, tokenPath)
+	if err != nil {
+		return Config{}, err
+	}
+	accessToken, err := source.Token(context.Background())
+	if err != nil {
+		return Config{}, err
+	}
+
+	cfg, err := ConfigFromSettingsForProfile(accessToken, settings, profile.Name)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.APIKeySource = KeySource{}
+	cfg.TokenSource = source
+	return cfg, nil
+}
+
+// validateAuthMethod validates the allowed authentication methods.
+func validateAuthMethod(method string, label string) error {
+	switch method {
+	case "", AuthMethodAPIKey, AuthMethodOAuth:
+		return nil
+	default:
+		return fmt.Errorf("invalid %s value %q: must be api_key or oauth", label, method)
+	}
+}
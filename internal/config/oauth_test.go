@@ -0,0 +1,89 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/oauth"
+)
+
+// TestConfigForProfileOAuth verifies OAuth profiles use the stored token.
+func TestConfigForProfileOAuth(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(LinearAPIKeyEnv, "")
+
+	settings := DefaultSettings()
+	settings.OAuth = &OAuthSettings{ClientID: "client-1"}
+	settings.Profiles = []Profile{{Name: "contractor", AuthMethod: AuthMethodOAuth}}
+
+	_, err := ConfigForProfile(settings, "contractor")
+	if !errors.Is(err, oauth.ErrNotLoggedIn) {
+		t.Fatalf("ConfigForProfile() error = %v, want ErrNotLoggedIn", err)
+	}
+
+	tokenPath, err := OAuthTokenFilePath("contractor")
+	if err != nil {
+		t.Fatalf("OAuthTokenFilePath() error: %v", err)
+	}
+	if err := oauth.SaveToken(tokenPath, oauth.Token{AccessToken: "access-1", TokenType: "Bearer", RefreshToken: "refresh-1"}); err != nil {
+		t.Fatalf("SaveToken() error: %v", err)
+	}
+
+	cfg, err := ConfigForProfile(settings, "contractor")
+	if err != nil {
+		t.Fatalf("ConfigForProfile() error: %v", err)
+	}
+	if cfg.TokenSource == nil || cfg.LinearAPIKey != "Bearer access-1" {
+		t.Errorf("config token = %q (source %v), want Bearer access-1", cfg.LinearAPIKey, cfg.TokenSource)
+	}
+	if cfg.APIKeySource.Refreshable() {
+		t.Error("OAuth config should not refresh through an API key source")
+	}
+	if cfg.AccountKey() != "oauth:client-1" {
+		t.Errorf("AccountKey() = %q, want oauth:client-1", cfg.AccountKey())
+	}
+	if got := SettingsFromConfig(cfg); got.OAuth == nil || got.OAuth.ClientID != "client-1" || got.AuthMethod != AuthMethodAPIKey {
+		t.Errorf("SettingsFromConfig() oauth = %+v, auth_method = %q", got.OAuth, got.AuthMethod)
+	}
+}
+
+// TestOAuthConfigDefaults verifies Linear's endpoints are used by default.
+func TestOAuthConfigDefaults(t *testing.T) {
+	t.Setenv(OAuthClientSecretEnv, "")
+
+	if _, err := OAuthConfig(DefaultSettings()); err == nil {
+		t.Error("OAuthConfig() expected error without client_id")
+	}
+
+	settings := DefaultSettings()
+	settings.OAuth = &OAuthSettings{ClientID: " client-1 ", TokenURL: "http://127.0.0.1:9999/token"}
+	cfg, err := OAuthConfig(settings)
+	if err != nil {
+		t.Fatalf("OAuthConfig() error: %v", err)
+	}
+	want := oauth.Config{
+		ClientID:     "client-1",
+		AuthorizeURL: oauth.DefaultAuthorizeURL,
+		TokenURL:     "http://127.0.0.1:9999/token",
+		RedirectURL:  oauth.DefaultRedirectURL,
+		Scopes:       oauth.DefaultScopes,
+	}
+	if cfg != want {
+		t.Errorf("OAuthConfig() = %+v, want %+v", cfg, want)
+	}
+}
+
+// TestConfigFromSettingsValidatesAuthMethod checks unknown auth methods are rejected.
+func TestConfigFromSettingsValidatesAuthMethod(t *testing.T) {
+	settings := DefaultSettings()
+	settings.AuthMethod = "password"
+	if _, err := ConfigFromSettings("test-key", settings); err == nil {
+		t.Error("ConfigFromSettings() expected error for invalid auth_method")
+	}
+
+	settings = DefaultSettings()
+	settings.Profiles = []Profile{{Name: "work", AuthMethod: "sso"}}
+	if _, err := ConfigFromSettings("test-key", settings); err == nil {
+		t.Error("ConfigFromSettings() expected error for invalid profile auth_method")
+	}
+}
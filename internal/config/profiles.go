@@ -0,0 +1,219 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Profile is a named Linear account. Engineers who belong to several
+// workspaces configure one profile per workspace and switch between them.
+type Profile struct {
+	// Name identifies the profile (letters, digits, "-" and "_").
+	Name string `json:"name"`
+
+	// APIKeyEnv is the environment variable holding the profile's API key
+	// (default LINEAR_API_KEY).
+	APIKeyEnv string `json:"api_key_env,omitempty"`
+
+	// APIKeyCommand is a credential helper command that prints the key.
+	APIKeyCommand string `json:"api_key_command,omitempty"`
+
+	// APIKeyFile is the path of a 0600 file containing the key.
+	APIKeyFile string `json:"api_key_file,omitempty"`
+
+	// AuthMethod overrides the global auth_method (api_key or oauth).
+	AuthMethod string `json:"auth_method,omitempty"`
+
+	// APIEndpoint overrides the global api_endpoint for this profile.
+	APIEndpoint string `json:"api_endpoint,omitempty"`
+
+	// DefaultTeam is the team key, name, or ID selected when the profile loads.
+	DefaultTeam string `json:"default_team,omitempty"`
+}
+
+// profileNamePattern restricts profile names to characters that are safe in
+// file names, since each profile keeps its own cache files.
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// KeyEnv returns the environment variable holding the profile's API key.
+func (p Profile) KeyEnv() string {
+	if env := strings.TrimSpace(p.APIKeyEnv); env != "" {
+		return env
+	}
+	return LinearAPIKeyEnv
+}
+
+// keySourceFor returns where the API key of profile is read from. Without a
+// profile, or when the profile names no key source of its own, the global
+// api_key_command, api_key_file, and LINEAR_API_KEY settings apply.
+func keySourceFor(settings Settings, profile Profile) KeySource {
+	if profile.APIKeyEnv == "" && profile.APIKeyCommand == "" && profile.APIKeyFile == "" {
+		return KeySource{
+			Env:     LinearAPIKeyEnv,
+			Command: strings.TrimSpace(settings.APIKeyCommand),
+			File:    strings.TrimSpace(settings.APIKeyFile),
+		}
+	}
+	return KeySource{
+		Env:     profile.KeyEnv(),
+		Command: strings.TrimSpace(profile.APIKeyCommand),
+		File:    strings.TrimSpace(profile.APIKeyFile),
+	}
+}
+
+// MissingAPIKeyError reports that the environment variable holding an API key
+// is not set.
+type MissingAPIKeyError struct {
+	Env string
+}
+
+// Error implements error.
+func (e *MissingAPIKeyError) Error() string {
+	return fmt.Sprintf("%s environment variable is not set", e.Env)
+}
+
+// FindProfile returns the profile with the given name.
+func FindProfile(profiles []Profile, name string) (Profile, bool) {
+	for _, profile := range profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return Profile{}, false
+}
+
+// ResolveProfile returns the profile to use for name, falling back to the
+// default profile of settings when name is empty. It returns the zero Profile
+// when no profile is requested or configured.
+func ResolveProfile(settings Settings, name string) (Profile, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = strings.TrimSpace(settings.Profile)
+	}
+	if name == "" {
+		return Profile{}, nil
+	}
+
+	profile, ok := FindProfile(settings.Profiles, name)
+	if !ok {
+		names := make([]string, 0, len(settings.Profiles))
+		for _, p := range settings.Profiles {
+			names = append(names, p.Name)
+		}
+		if len(names) == 0 {
+			return Profile{}, fmt.Errorf("unknown profile %q: no profiles are configured", name)
+		}
+		return Profile{}, fmt.Errorf("unknown profile %q: must be one of %s", name, strings.Join(names, ", "))
+	}
+	return profile, nil
+}
+
+// ConfigForProfile builds runtime configuration for the named profile (or the
+// default profile when name is empty), fetching the API key from the
+// profile's key source or loading its OAuth token.
+func ConfigForProfile(settings Settings, name string) (Config, error) {
+	profile, err := ResolveProfile(settings, name)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if AuthMethodFor(settings, profile) == AuthMethodOAuth {
+		return configForOAuth(settings, profile)
+	}
+
+	apiKey, err := keySourceFor(settings, profile).Resolve(context.Background())
+	if err != nil {
+		return Config{}, err
+	}
+
+	return ConfigFromSettingsForProfile(apiKey, settings, profile.Name)
+}
+
+// ConfigFromSettingsForProfile builds runtime configuration from settings and
+// an API key, applying the endpoint and default team of the named profile.
+func ConfigFromSettingsForProfile(apiKey string, settings Settings, name string) (Config, error) {
+	cfg, err := ConfigFromSettings(apiKey, settings)
+	if err != nil {
+		return Config{}, err
+	}
+	if name == "" {
+		return cfg, nil
+	}
+
+	profile, ok := FindProfile(cfg.Profiles, name)
+	if !ok {
+		return Config{}, fmt.Errorf("unknown profile %q", name)
+	}
+	cfg.Profile = profile.Name
+	cfg.APIKeySource = keySourceFor(settings, profile)
+	cfg.ProfileAPIEndpoint = strings.TrimSpace(profile.APIEndpoint)
+	cfg.DefaultTeam = strings.TrimSpace(profile.DefaultTeam)
+	return cfg, nil
+}
+
+// ExtractProfileFlag removes a --profile flag ("--profile NAME" or
+// "--profile=NAME") from args and returns its value and the remaining args.
+func ExtractProfileFlag(args []string) (string, []string, error) {
+	var profile string
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--profile":
+			if i+1 >= len(args) || strings.HasPrefix(args[i+1], "-") {
+				return "", nil, errors.New("--profile requires a profile name")
+			}
+			profile = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--profile="):
+			profile = strings.TrimPrefix(arg, "--profile=")
+			if profile == "" {
+				return "", nil, errors.New("--profile requires a profile name")
+			}
+		default:
+			rest = append(rest, arg)
+		}
+	}
+	return profile, rest, nil
+}
+
+// ProfileFilePath returns the per-profile variant of a data file path, e.g.
+// cache.json becomes cache-work.json for the "work" profile. The path is
+// returned unchanged when profile is empty.
+func ProfileFilePath(path, profile string) string {
+	if profile == "" {
+		return path
+	}
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "-" + profile + ext
+}
+
+// validateProfiles validates profile names and the default profile.
+func validateProfiles(profiles []Profile, defaultProfile string, label string) error {
+	seen := make(map[string]bool, len(profiles))
+	for _, profile := range profiles {
+		if !profileNamePattern.MatchString(profile.Name) {
+			return fmt.Errorf("invalid %s name %q: use letters, digits, \"-\" and \"_\"", label, profile.Name)
+		}
+		if seen[profile.Name] {
+			return fmt.Errorf("duplicate %s name %q", label, profile.Name)
+		}
+		seen[profile.Name] = true
+		if err := validateKeySource(profile.APIKeyCommand, profile.APIKeyFile, "profile "+profile.Name); err != nil {
+			return err
+		}
+		if err := validateAuthMethod(profile.AuthMethod, "profile "+profile.Name+" auth_method"); err != nil {
+			return err
+		}
+	}
+
+	if defaultProfile != "" && !seen[defaultProfile] {
+		return fmt.Errorf("invalid profile value %q: no such entry in %s", defaultProfile, label)
+	}
+
+	return nil
+}
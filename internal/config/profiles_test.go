@@ -0,0 +1,176 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// profileSettings returns settings with a work and a personal profile.
+func profileSettings() Settings {
+	settings := DefaultSettings()
+	settings.Profile = "work"
+	settings.Profiles = []Profile{
+		{Name: "work", APIKeyEnv: "LINEAR_WORK_KEY", DefaultTeam: "ENG"},
+		{Name: "personal", APIKeyEnv: "LINEAR_PERSONAL_KEY", APIEndpoint: "https://linear.example.com/graphql"},
+	}
+	return settings
+}
+
+// TestConfigForProfile verifies the profile key, endpoint, and team are applied.
+func TestConfigForProfile(t *testing.T) {
+	t.Setenv("LINEAR_WORK_KEY", "work-key")
+	t.Setenv("LINEAR_PERSONAL_KEY", "personal-key")
+	settings := profileSettings()
+
+	cfg, err := ConfigForProfile(settings, "")
+	if err != nil {
+		t.Fatalf("ConfigForProfile() error: %v", err)
+	}
+	if cfg.Profile != "work" || cfg.LinearAPIKey != "work-key" || cfg.DefaultTeam != "ENG" {
+		t.Errorf("default profile config = %q/%q/%q, want work/work-key/ENG", cfg.Profile, cfg.LinearAPIKey, cfg.DefaultTeam)
+	}
+	if cfg.Endpoint() != DefaultAPIEndpoint {
+		t.Errorf("Endpoint() = %q, want %q", cfg.Endpoint(), DefaultAPIEndpoint)
+	}
+
+	cfg, err = ConfigForProfile(settings, "personal")
+	if err != nil {
+		t.Fatalf("ConfigForProfile() error: %v", err)
+	}
+	if cfg.Profile != "personal" || cfg.LinearAPIKey != "personal-key" || cfg.DefaultTeam != "" {
+		t.Errorf("personal config = %q/%q/%q, want personal/personal-key/empty", cfg.Profile, cfg.LinearAPIKey, cfg.DefaultTeam)
+	}
+	if cfg.Endpoint() != "https://linear.example.com/graphql" {
+		t.Errorf("Endpoint() = %q, want profile endpoint", cfg.Endpoint())
+	}
+	if cfg.DefaultProfile != "work" || len(cfg.Profiles) != 2 {
+		t.Errorf("profiles = %q/%d, want work/2", cfg.DefaultProfile, len(cfg.Profiles))
+	}
+
+	// Settings round-trip keeps the profiles and the default profile
+	if got := SettingsFromConfig(cfg); got.Profile != "work" || !reflect.DeepEqual(got.Profiles, settings.Profiles) {
+		t.Errorf("SettingsFromConfig() profiles = %q/%+v", got.Profile, got.Profiles)
+	}
+}
+
+// TestConfigForProfileErrors verifies unknown profiles and missing keys are reported.
+func TestConfigForProfileErrors(t *testing.T) {
+	t.Setenv("LINEAR_WORK_KEY", "")
+	settings := profileSettings()
+
+	if _, err := ConfigForProfile(settings, "missing"); err == nil {
+		t.Error("ConfigForProfile() expected error for unknown profile")
+	}
+
+	_, err := ConfigForProfile(settings, "work")
+	var missing *MissingAPIKeyError
+	if !errors.As(err, &missing) || missing.Env != "LINEAR_WORK_KEY" {
+		t.Errorf("ConfigForProfile() error = %v, want missing LINEAR_WORK_KEY", err)
+	}
+}
+
+// TestConfigForProfileWithoutProfiles verifies LINEAR_API_KEY is used when no profiles exist.
+func TestConfigForProfileWithoutProfiles(t *testing.T) {
+	t.Setenv(LinearAPIKeyEnv, "plain-key")
+
+	cfg, err := ConfigForProfile(DefaultSettings(), "")
+	if err != nil {
+		t.Fatalf("ConfigForProfile() error: %v", err)
+	}
+	if cfg.Profile != "" || cfg.LinearAPIKey != "plain-key" {
+		t.Errorf("config = %q/%q, want no profile and plain-key", cfg.Profile, cfg.LinearAPIKey)
+	}
+}
+
+// TestConfigFromSettingsValidatesProfiles checks invalid profile definitions are rejected.
+func TestConfigFromSettingsValidatesProfiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  string
+		profiles []Profile
+	}{
+		{name: "empty name", profiles: []Profile{{Name: ""}}},
+		{name: "unsafe name", profiles: []Profile{{Name: "../work"}}},
+		{name: "duplicate name", profiles: []Profile{{Name: "work"}, {Name: "work"}}},
+		{name: "unknown default", profile: "home", profiles: []Profile{{Name: "work"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := DefaultSettings()
+			settings.Profile = tt.profile
+			settings.Profiles = tt.profiles
+			if _, err := ConfigFromSettings("test-key", settings); err == nil {
+				t.Errorf("ConfigFromSettings() expected error for %s", tt.name)
+			}
+		})
+	}
+}
+
+// TestLoadSettingsProfiles verifies profiles are read from the settings file.
+func TestLoadSettingsProfiles(t *testing.T) {
+	settingsPath := filepath.Join(t.TempDir(), "config.json")
+	data := []byte(`{"profile":"work","profiles":[{"name":"work","api_key_env":"LINEAR_WORK_KEY","default_team":"ENG"}]}`)
+	if err := os.WriteFile(settingsPath, data, 0644); err != nil {
+		t.Fatalf("write settings file: %v", err)
+	}
+
+	settings, err := LoadSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadSettings() error: %v", err)
+	}
+
+	expected := DefaultSettings()
+	expected.Profile = "work"
+	expected.Profiles = []Profile{{Name: "work", APIKeyEnv: "LINEAR_WORK_KEY", DefaultTeam: "ENG"}}
+	assertSettingsEqual(t, settings, expected)
+}
+
+// TestExtractProfileFlag verifies both --profile forms are removed from args.
+func TestExtractProfileFlag(t *testing.T) {
+	tests := []struct {
+		args        []string
+		wantProfile string
+		wantArgs    []string
+		wantErr     bool
+	}{
+		{args: []string{}, wantArgs: []string{}},
+		{args: []string{"--profile", "work"}, wantProfile: "work", wantArgs: []string{}},
+		{args: []string{"issues", "list", "--profile=home", "--json"}, wantProfile: "home", wantArgs: []string{"issues", "list", "--json"}},
+		{args: []string{"--profile"}, wantErr: true},
+		{args: []string{"--profile", "--json"}, wantErr: true},
+		{args: []string{"--profile="}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		profile, args, err := ExtractProfileFlag(tt.args)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ExtractProfileFlag(%q) expected error", tt.args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ExtractProfileFlag(%q) error: %v", tt.args, err)
+			continue
+		}
+		if profile != tt.wantProfile || !reflect.DeepEqual(args, tt.wantArgs) {
+			t.Errorf("ExtractProfileFlag(%q) = %q, %q; want %q, %q", tt.args, profile, args, tt.wantProfile, tt.wantArgs)
+		}
+	}
+}
+
+// TestProfileFilePath verifies per-profile data file names.
+func TestProfileFilePath(t *testing.T) {
+	path := filepath.Join("home", ".linear-tui", "cache.json")
+	if got := ProfileFilePath(path, ""); got != path {
+		t.Errorf("ProfileFilePath(no profile) = %q, want %q", got, path)
+	}
+	want := filepath.Join("home", ".linear-tui", "cache-work.json")
+	if got := ProfileFilePath(path, "work"); got != want {
+		t.Errorf("ProfileFilePath(work) = %q, want %q", got, want)
+	}
+}
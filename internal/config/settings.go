@@ -12,40 +12,62 @@ import (
 
 // SettingsFile represents the on-disk JSON with optional fields.
 type SettingsFile struct {
-	APIEndpoint    *string `json:"api_endpoint"`
-	Timeout        *string `json:"timeout"`
-	PageSize       *int    `json:"page_size"`
-	CacheTTL       *string `json:"cache_ttl"`
-	LogFile        *string `json:"log_file"`
-	LogLevel       *string `json:"log_level"`
-	Theme          *string `json:"theme"`
-	Density        *string `json:"density"`
-	AgentProvider  *string `json:"agent_provider"`
-	AgentSandbox   *string `json:"agent_sandbox"`
-	AgentModel     *string `json:"agent_model"`
-	AgentWorkspace *string `json:"agent_workspace"`
+	APIEndpoint    *string        `json:"api_endpoint"`
+	APIKeyCommand  *string        `json:"api_key_command"`
+	APIKeyFile     *string        `json:"api_key_file"`
+	Timeout        *string        `json:"timeout"`
+	PageSize       *int           `json:"page_size"`
+	CacheTTL       *string        `json:"cache_ttl"`
+	LogFile        *string        `json:"log_file"`
+	LogLevel       *string        `json:"log_level"`
+	Theme          *string        `json:"theme"`
+	Density        *string        `json:"density"`
+	IssuesLayout   *string        `json:"issues_layout"`
+	AgentProvider  *string        `json:"agent_provider"`
+	AgentSandbox   *string        `json:"agent_sandbox"`
+	AgentModel     *string        `json:"agent_model"`
+	AgentWorkspace *string        `json:"agent_workspace"`
+	AgentProviders []AgentCommand `json:"agent_providers"`
+	WebhookAddr    *string        `json:"webhook_addr"`
+	PollInterval   *string        `json:"poll_interval"`
+	AuthMethod     *string        `json:"auth_method"`
+	OAuth          *OAuthSettings `json:"oauth"`
+	Profile        *string        `json:"profile"`
+	Profiles       []Profile      `json:"profiles"`
 }
 
 // Settings contains concrete settings values for UI and persistence.
 type Settings struct {
-	APIEndpoint    string `json:"api_endpoint"`
-	Timeout        string `json:"timeout"`
-	PageSize       int    `json:"page_size"`
-	CacheTTL       string `json:"cache_ttl"`
-	LogFile        string `json:"log_file"`
-	LogLevel       string `json:"log_level"`
-	Theme          string `json:"theme"`
-	Density        string `json:"density"`
-	AgentProvider  string `json:"agent_provider"`
-	AgentSandbox   string `json:"agent_sandbox"`
-	AgentModel     string `json:"agent_model"`
-	AgentWorkspace string `json:"agent_workspace"`
+	APIEndpoint    string         `json:"api_endpoint"`
+	APIKeyCommand  string         `json:"api_key_command"`
+	APIKeyFile     string         `json:"api_key_file"`
+	Timeout        string         `json:"timeout"`
+	PageSize       int            `json:"page_size"`
+	CacheTTL       string         `json:"cache_ttl"`
+	LogFile        string         `json:"log_file"`
+	LogLevel       string         `json:"log_level"`
+	Theme          string         `json:"theme"`
+	Density        string         `json:"density"`
+	IssuesLayout   string         `json:"issues_layout"`
+	AgentProvider  string         `json:"agent_provider"`
+	AgentSandbox   string         `json:"agent_sandbox"`
+	AgentModel     string         `json:"agent_model"`
+	AgentWorkspace string         `json:"agent_workspace"`
+	AgentProviders []AgentCommand `json:"agent_providers,omitempty"`
+	WebhookAddr    string         `json:"webhook_addr"`
+	PollInterval   string         `json:"poll_interval"`
+	AuthMethod     string         `json:"auth_method"`
+	OAuth          *OAuthSettings `json:"oauth,omitempty"`
+	Profile        string         `json:"profile,omitempty"`
+	Profiles       []Profile      `json:"profiles,omitempty"`
 }
 
 // DefaultSettings returns the default settings for the config file and UI.
 func DefaultSettings() Settings {
 	return Settings{
 		APIEndpoint:    DefaultAPIEndpoint,
+		APIKeyCommand:  "",
+		APIKeyFile:     "",
 		Timeout:        DefaultTimeout.String(),
 		PageSize:       DefaultPageSize,
 		CacheTTL:       DefaultCacheTTL.String(),
@@ -53,10 +75,14 @@ func DefaultSettings() Settings {
 		LogLevel:       DefaultLogLevel,
 		Theme:          DefaultTheme,
 		Density:        DefaultDensity,
+		IssuesLayout:   DefaultIssuesLayout,
 		AgentProvider:  DefaultAgentProvider,
 		AgentSandbox:   DefaultAgentSandbox,
 		AgentModel:     "",
 		AgentWorkspace: "",
+		WebhookAddr:    "",
+		PollInterval:   DefaultPollInterval.String(),
+		AuthMethod:     DefaultAuthMethod,
 	}
 }
 
@@ -64,6 +90,8 @@ func DefaultSettings() Settings {
 func SettingsFromConfig(cfg Config) Settings {
 	return Settings{
 		APIEndpoint:    cfg.APIEndpoint,
+		APIKeyCommand:  cfg.APIKeyCommand,
+		APIKeyFile:     cfg.APIKeyFile,
 		Timeout:        cfg.Timeout.String(),
 		PageSize:       cfg.PageSize,
 		CacheTTL:       cfg.CacheTTL.String(),
@@ -71,17 +99,25 @@ func SettingsFromConfig(cfg Config) Settings {
 		LogLevel:       cfg.LogLevel,
 		Theme:          cfg.Theme,
 		Density:        cfg.Density,
+		IssuesLayout:   cfg.IssuesLayout,
 		AgentProvider:  cfg.AgentProvider,
 		AgentSandbox:   cfg.AgentSandbox,
 		AgentModel:     cfg.AgentModel,
 		AgentWorkspace: cfg.AgentWorkspace,
+		AgentProviders: cfg.AgentCommands,
+		WebhookAddr:    cfg.WebhookAddr,
+		PollInterval:   cfg.PollInterval.String(),
+		AuthMethod:     cfg.AuthMethod,
+		OAuth:          cfg.OAuth,
+		Profile:        cfg.DefaultProfile,
+		Profiles:       cfg.Profiles,
 	}
 }
 
 // ConfigFromSettings builds runtime configuration from settings and API key.
 func ConfigFromSettings(apiKey string, settings Settings) (Config, error) {
 	if apiKey == "" {
-		return Config{}, fmt.Errorf("%s environment variable is not set", LinearAPIKeyEnv)
+		return Config{}, &MissingAPIKeyError{Env: LinearAPIKeyEnv}
 	}
 
 	timeout, err := parseDuration(settings.Timeout, "timeout")
@@ -118,7 +154,19 @@ func ConfigFromSettings(apiKey string, settings Settings) (Config, error) {
 		return Config{}, err
 	}
 
-	if err := validateAgentProvider(settings.AgentProvider, "agent_provider"); err != nil {
+	issuesLayout := strings.TrimSpace(settings.IssuesLayout)
+	if issuesLayout == "" {
+		issuesLayout = DefaultIssuesLayout
+	}
+	if err := validateIssuesLayout(issuesLayout, "issues_layout"); err != nil {
+		return Config{}, err
+	}
+
+	if err := validateAgentCommands(settings.AgentProviders, "agent_providers"); err != nil {
+		return Config{}, err
+	}
+
+	if err := validateAgentProvider(settings.AgentProvider, settings.AgentProviders, "agent_provider"); err != nil {
 		return Config{}, err
 	}
 
@@ -126,8 +174,45 @@ func ConfigFromSettings(apiKey string, settings Settings) (Config, error) {
 		return Config{}, err
 	}
 
+	pollInterval := DefaultPollInterval
+	if value := strings.TrimSpace(settings.PollInterval); value != "" {
+		pollInterval, err = parseDuration(value, "poll_interval")
+		if err != nil {
+			return Config{}, err
+		}
+		if err := validatePollInterval(pollInterval, "poll_interval"); err != nil {
+			return Config{}, err
+		}
+	}
+
+	webhookAddr := strings.TrimSpace(settings.WebhookAddr)
+	webhookSecret := os.Getenv(WebhookSecretEnv)
+	if webhookAddr != "" && webhookSecret == "" {
+		return Config{}, fmt.Errorf("webhook_addr is set but %s environment variable is not set", WebhookSecretEnv)
+	}
+
+	if err := validateKeySource(settings.APIKeyCommand, settings.APIKeyFile, "settings"); err != nil {
+		return Config{}, err
+	}
+
+	authMethod := strings.TrimSpace(settings.AuthMethod)
+	if authMethod == "" {
+		authMethod = DefaultAuthMethod
+	}
+	if err := validateAuthMethod(authMethod, "auth_method"); err != nil {
+		return Config{}, err
+	}
+
+	defaultProfile := strings.TrimSpace(settings.Profile)
+	if err := validateProfiles(settings.Profiles, defaultProfile, "profiles"); err != nil {
+		return Config{}, err
+	}
+
 	return Config{
 		LinearAPIKey:   apiKey,
+		APIKeySource:   keySourceFor(settings, Profile{}),
+		APIKeyCommand:  strings.TrimSpace(settings.APIKeyCommand),
+		APIKeyFile:     strings.TrimSpace(settings.APIKeyFile),
 		APIEndpoint:    settings.APIEndpoint,
 		Timeout:        timeout,
 		PageSize:       settings.PageSize,
@@ -136,10 +221,19 @@ func ConfigFromSettings(apiKey string, settings Settings) (Config, error) {
 		LogLevel:       settings.LogLevel,
 		Theme:          theme,
 		Density:        density,
+		IssuesLayout:   issuesLayout,
 		AgentProvider:  settings.AgentProvider,
 		AgentSandbox:   settings.AgentSandbox,
 		AgentModel:     settings.AgentModel,
 		AgentWorkspace: settings.AgentWorkspace,
+		AgentCommands:  settings.AgentProviders,
+		WebhookAddr:    webhookAddr,
+		WebhookSecret:  webhookSecret,
+		PollInterval:   pollInterval,
+		AuthMethod:     authMethod,
+		OAuth:          settings.OAuth,
+		Profiles:       settings.Profiles,
+		DefaultProfile: defaultProfile,
 	}, nil
 }
 
@@ -153,6 +247,36 @@ func ConfigFilePath() (string, error) {
 	return filepath.Join(homeDir, ".linear-tui", "config.json"), nil
 }
 
+// CacheFilePath returns the default on-disk issue cache path.
+func CacheFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, ".linear-tui", "cache.json"), nil
+}
+
+// OfflineQueueFilePath returns the default path of the offline mutation queue.
+func OfflineQueueFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, ".linear-tui", "offline_queue.json"), nil
+}
+
+// AgentRunsDirPath returns the directory agent run transcripts are kept in.
+func AgentRunsDirPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, ".linear-tui", "runs"), nil
+}
+
 // EnsureSettingsFile ensures the settings file exists and returns its settings.
 func EnsureSettingsFile(path string) (Settings, error) {
 	if path == "" {
@@ -193,6 +317,12 @@ func LoadSettings(path string) (Settings, error) {
 	if file.APIEndpoint != nil {
 		settings.APIEndpoint = *file.APIEndpoint
 	}
+	if file.APIKeyCommand != nil {
+		settings.APIKeyCommand = *file.APIKeyCommand
+	}
+	if file.APIKeyFile != nil {
+		settings.APIKeyFile = *file.APIKeyFile
+	}
 	if file.Timeout != nil {
 		settings.Timeout = *file.Timeout
 	}
@@ -214,6 +344,9 @@ func LoadSettings(path string) (Settings, error) {
 	if file.Density != nil {
 		settings.Density = *file.Density
 	}
+	if file.IssuesLayout != nil {
+		settings.IssuesLayout = *file.IssuesLayout
+	}
 	if file.AgentProvider != nil {
 		settings.AgentProvider = *file.AgentProvider
 	}
@@ -226,6 +359,21 @@ func LoadSettings(path string) (Settings, error) {
 	if file.AgentWorkspace != nil {
 		settings.AgentWorkspace = *file.AgentWorkspace
 	}
+	settings.AgentProviders = file.AgentProviders
+	if file.WebhookAddr != nil {
+		settings.WebhookAddr = *file.WebhookAddr
+	}
+	if file.PollInterval != nil {
+		settings.PollInterval = *file.PollInterval
+	}
+	if file.AuthMethod != nil {
+		settings.AuthMethod = *file.AuthMethod
+	}
+	settings.OAuth = file.OAuth
+	if file.Profile != nil {
+		settings.Profile = *file.Profile
+	}
+	settings.Profiles = file.Profiles
 
 	return settings, nil
 }
@@ -303,13 +451,13 @@ func validateDensity(density string, label string) error {
 	}
 }
 
-// validateAgentProvider validates the allowed agent providers.
-func validateAgentProvider(provider string, label string) error {
-	switch provider {
-	case "cursor", "claude":
+// validateIssuesLayout validates the allowed issues layout values.
+func validateIssuesLayout(layout string, label string) error {
+	switch layout {
+	case IssuesLayoutTable, IssuesLayoutBoard:
 		return nil
 	default:
-		return fmt.Errorf("invalid %s value %q: must be cursor or claude", label, provider)
+		return fmt.Errorf("invalid %s value %q: must be table or board", label, layout)
 	}
 }
 
@@ -322,3 +470,12 @@ func validateAgentSandbox(sandbox string, label string) error {
 		return fmt.Errorf("invalid %s value %q: must be enabled or disabled", label, sandbox)
 	}
 }
+
+// validatePollInterval validates the polling interval (0 disables polling).
+func validatePollInterval(interval time.Duration, label string) error {
+	if interval != 0 && interval < MinPollInterval {
+		return fmt.Errorf("%s must be 0 or at least %s, got %s", label, MinPollInterval, interval)
+	}
+
+	return nil
+}
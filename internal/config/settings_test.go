@@ -125,6 +125,13 @@ func TestConfigFromSettingsValidation(t *testing.T) {
 				return settings
 			},
 		},
+		{
+			name: "invalid issues layout",
+			mutate: func(settings Settings) Settings {
+				settings.IssuesLayout = "gantt"
+				return settings
+			},
+		},
 		{
 			name: "invalid agent provider",
 			mutate: func(settings Settings) Settings {
@@ -139,8 +146,30 @@ func TestConfigFromSettingsValidation(t *testing.T) {
 				return settings
 			},
 		},
+		{
+			name: "invalid poll interval",
+			mutate: func(settings Settings) Settings {
+				settings.PollInterval = "often"
+				return settings
+			},
+		},
+		{
+			name: "poll interval too short",
+			mutate: func(settings Settings) Settings {
+				settings.PollInterval = "1s"
+				return settings
+			},
+		},
+		{
+			name: "webhook address without secret",
+			mutate: func(settings Settings) Settings {
+				settings.WebhookAddr = "127.0.0.1:8787"
+				return settings
+			},
+		},
 	}
 
+	t.Setenv(WebhookSecretEnv, "")
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			settings := tt.mutate(base)
@@ -160,6 +189,36 @@ func TestConfigFromSettingsRequiresAPIKey(t *testing.T) {
 	}
 }
 
+// TestConfigFromSettingsLiveUpdates verifies webhook and polling settings.
+func TestConfigFromSettingsLiveUpdates(t *testing.T) {
+	t.Setenv(WebhookSecretEnv, "secret")
+
+	settings := DefaultSettings()
+	settings.WebhookAddr = " 127.0.0.1:8787 "
+	cfg, err := ConfigFromSettings("test-key", settings)
+	if err != nil {
+		t.Fatalf("ConfigFromSettings() error: %v", err)
+	}
+	if cfg.WebhookAddr != "127.0.0.1:8787" || cfg.WebhookSecret != "secret" {
+		t.Errorf("webhook = %q/%q, want 127.0.0.1:8787/secret", cfg.WebhookAddr, cfg.WebhookSecret)
+	}
+	if cfg.PollInterval != DefaultPollInterval {
+		t.Errorf("PollInterval = %v, want %v", cfg.PollInterval, DefaultPollInterval)
+	}
+
+	settings.PollInterval = "0"
+	cfg, err = ConfigFromSettings("test-key", settings)
+	if err != nil {
+		t.Fatalf("ConfigFromSettings() error: %v", err)
+	}
+	if cfg.PollInterval != 0 {
+		t.Errorf("PollInterval = %v, want 0 (disabled)", cfg.PollInterval)
+	}
+	if got := SettingsFromConfig(cfg); got.WebhookAddr != cfg.WebhookAddr || got.PollInterval != "0s" {
+		t.Errorf("SettingsFromConfig() = %+v", got)
+	}
+}
+
 // TestDefaultSettingsAgentDefaults verifies agent defaults are set.
 func TestDefaultSettingsAgentDefaults(t *testing.T) {
 	settings := DefaultSettings()
@@ -181,6 +240,9 @@ func TestDefaultSettingsAgentDefaults(t *testing.T) {
 	if settings.Density != DefaultDensity {
 		t.Errorf("Density = %q, want %q", settings.Density, DefaultDensity)
 	}
+	if settings.IssuesLayout != DefaultIssuesLayout {
+		t.Errorf("IssuesLayout = %q, want %q", settings.IssuesLayout, DefaultIssuesLayout)
+	}
 }
 
 // assertSettingsEqual compares settings values in tests.
@@ -0,0 +1,200 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SavedView is a named issue filter with its sort order and grouping.
+type SavedView struct {
+	Name      string `json:"name"`
+	Query     string `json:"query,omitempty"`      // Search query, including field terms
+	Sort      string `json:"sort,omitempty"`       // Sort field: updatedAt, createdAt, or priority
+	Layout    string `json:"layout,omitempty"`     // Issues layout: table or board
+	TeamID    string `json:"team_id,omitempty"`    // Navigation scope
+	ProjectID string `json:"project_id,omitempty"` // Navigation scope (requires team_id)
+	StateID   string `json:"state_id,omitempty"`   // Navigation scope (requires team_id)
+	CycleID   string `json:"cycle_id,omitempty"`   // Navigation scope (requires team_id)
+	Default   bool   `json:"default,omitempty"`    // Selected at startup
+}
+
+// ViewsFilePath returns the default saved views file path.
+func ViewsFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linear-tui", "views.json"), nil
+}
+
+// LoadSavedViews loads saved views from a JSON file. A missing file means no
+// views have been saved yet.
+func LoadSavedViews(path string) ([]SavedView, error) {
+	if path == "" {
+		return nil, fmt.Errorf("views path is empty")
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read views file: %w", err)
+	}
+
+	var views []SavedView
+	if err := json.Unmarshal(data, &views); err != nil {
+		return nil, fmt.Errorf("parse views file: %w", err)
+	}
+
+	return normalizeSavedViews(views), nil
+}
+
+// SaveSavedViews writes saved views to a JSON file, creating directories as needed.
+func SaveSavedViews(path string, views []SavedView) error {
+	if path == "" {
+		return fmt.Errorf("views path is empty")
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create views directory: %w", err)
+	}
+
+	if views == nil {
+		views = []SavedView{}
+	}
+	data, err := json.MarshalIndent(views, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal views: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("write views file: %w", err)
+	}
+
+	return nil
+}
+
+// FindSavedView returns the view with the given name (case-insensitive).
+func FindSavedView(views []SavedView, name string) (SavedView, bool) {
+	if i := savedViewIndex(views, name); i >= 0 {
+		return views[i], true
+	}
+	return SavedView{}, false
+}
+
+// DefaultSavedView returns the view selected at startup, if any.
+func DefaultSavedView(views []SavedView) (SavedView, bool) {
+	for _, view := range views {
+		if view.Default {
+			return view, true
+		}
+	}
+	return SavedView{}, false
+}
+
+// PutSavedView adds view, replacing an existing view with the same name. A
+// replaced view keeps its startup default flag.
+func PutSavedView(views []SavedView, view SavedView) ([]SavedView, error) {
+	view.Name = strings.TrimSpace(view.Name)
+	if view.Name == "" {
+		return nil, fmt.Errorf("view name is empty")
+	}
+
+	updated := append([]SavedView(nil), views...)
+	if i := savedViewIndex(updated, view.Name); i >= 0 {
+		view.Default = updated[i].Default
+		updated[i] = view
+		return updated, nil
+	}
+	view.Default = false
+	return append(updated, view), nil
+}
+
+// RenameSavedView renames the view called oldName to newName.
+func RenameSavedView(views []SavedView, oldName, newName string) ([]SavedView, error) {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return nil, fmt.Errorf("view name is empty")
+	}
+	i := savedViewIndex(views, oldName)
+	if i < 0 {
+		return nil, fmt.Errorf("view %q not found", oldName)
+	}
+	if j := savedViewIndex(views, newName); j >= 0 && j != i {
+		return nil, fmt.Errorf("view %q already exists", views[j].Name)
+	}
+
+	updated := append([]SavedView(nil), views...)
+	updated[i].Name = newName
+	return updated, nil
+}
+
+// DeleteSavedView removes the view called name.
+func DeleteSavedView(views []SavedView, name string) ([]SavedView, error) {
+	i := savedViewIndex(views, name)
+	if i < 0 {
+		return nil, fmt.Errorf("view %q not found", name)
+	}
+
+	updated := make([]SavedView, 0, len(views)-1)
+	updated = append(updated, views[:i]...)
+	return append(updated, views[i+1:]...), nil
+}
+
+// SetDefaultSavedView marks the view called name as the startup default and
+// clears the flag on every other view. An empty name clears the default.
+func SetDefaultSavedView(views []SavedView, name string) ([]SavedView, error) {
+	i := -1
+	if name != "" {
+		if i = savedViewIndex(views, name); i < 0 {
+			return nil, fmt.Errorf("view %q not found", name)
+		}
+	}
+
+	updated := append([]SavedView(nil), views...)
+	for j := range updated {
+		updated[j].Default = j == i
+	}
+	return updated, nil
+}
+
+// savedViewIndex returns the index of the view called name, or -1.
+func savedViewIndex(views []SavedView, name string) int {
+	name = strings.TrimSpace(name)
+	for i, view := range views {
+		if strings.EqualFold(view.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// normalizeSavedViews trims names, drops unnamed and duplicate views, clears
+// unknown layouts, and keeps at most one startup default.
+func normalizeSavedViews(views []SavedView) []SavedView {
+	valid := make([]SavedView, 0, len(views))
+	hasDefault := false
+	for _, view := range views {
+		view.Name = strings.TrimSpace(view.Name)
+		view.Query = strings.TrimSpace(view.Query)
+		if view.Name == "" || savedViewIndex(valid, view.Name) >= 0 {
+			continue
+		}
+		if view.Layout != "" && validateIssuesLayout(view.Layout, "layout") != nil {
+			view.Layout = ""
+		}
+		if view.Default && hasDefault {
+			view.Default = false
+		}
+		hasDefault = hasDefault || view.Default
+		valid = append(valid, view)
+	}
+	return valid
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestLoadSavedViewsMissingFile verifies a missing views file means no views.
+func TestLoadSavedViewsMissingFile(t *testing.T) {
+	views, err := LoadSavedViews(filepath.Join(t.TempDir(), "views.json"))
+	if err != nil {
+		t.Fatalf("LoadSavedViews() error: %v", err)
+	}
+	if len(views) != 0 {
+		t.Errorf("LoadSavedViews() = %+v, want no views", views)
+	}
+}
+
+// TestSaveAndLoadSavedViews verifies views round-trip through the views file.
+func TestSaveAndLoadSavedViews(t *testing.T) {
+	viewsPath := filepath.Join(t.TempDir(), "nested", "views.json")
+	views := []SavedView{
+		{Name: "My bugs", Query: "assignee:me label:bug", Sort: "priority", Layout: IssuesLayoutBoard, Default: true},
+		{Name: "Eng backlog", TeamID: "team-1", StateID: "state-1"},
+	}
+
+	if err := SaveSavedViews(viewsPath, views); err != nil {
+		t.Fatalf("SaveSavedViews() error: %v", err)
+	}
+	loaded, err := LoadSavedViews(viewsPath)
+	if err != nil {
+		t.Fatalf("LoadSavedViews() error: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, views) {
+		t.Errorf("LoadSavedViews() = %+v, want %+v", loaded, views)
+	}
+}
+
+// TestLoadSavedViewsNormalizes verifies invalid entries are dropped or cleaned up.
+func TestLoadSavedViewsNormalizes(t *testing.T) {
+	viewsPath := filepath.Join(t.TempDir(), "views.json")
+	data := []byte(`[
+  {"name": "  ", "query": "ignored"},
+  {"name": " Bugs ", "query": " label:bug ", "layout": "grid", "default": true},
+  {"name": "bugs", "query": "duplicate"},
+  {"name": "Mine", "query": "assignee:me", "default": true}
+]`)
+	if err := os.WriteFile(viewsPath, data, 0644); err != nil {
+		t.Fatalf("write views file: %v", err)
+	}
+
+	views, err := LoadSavedViews(viewsPath)
+	if err != nil {
+		t.Fatalf("LoadSavedViews() error: %v", err)
+	}
+
+	expected := []SavedView{
+		{Name: "Bugs", Query: "label:bug", Default: true},
+		{Name: "Mine", Query: "assignee:me"},
+	}
+	if !reflect.DeepEqual(views, expected) {
+		t.Errorf("LoadSavedViews() = %+v, want %+v", views, expected)
+	}
+}
+
+// TestSavedViewEdits verifies adding, renaming, deleting, and defaulting views.
+func TestSavedViewEdits(t *testing.T) {
+	views, err := PutSavedView(nil, SavedView{Name: "Bugs", Query: "label:bug"})
+	if err != nil {
+		t.Fatalf("PutSavedView() error: %v", err)
+	}
+	views, _ = PutSavedView(views, SavedView{Name: "Mine", Query: "assignee:me"})
+
+	views, err = SetDefaultSavedView(views, "bugs")
+	if err != nil {
+		t.Fatalf("SetDefaultSavedView() error: %v", err)
+	}
+	if view, ok := DefaultSavedView(views); !ok || view.Name != "Bugs" {
+		t.Errorf("DefaultSavedView() = %+v, %v; want Bugs", view, ok)
+	}
+
+	// Replacing a view keeps its default flag
+	views, _ = PutSavedView(views, SavedView{Name: "BUGS", Query: "label:bug priority:urgent"})
+	if len(views) != 2 || !views[0].Default || views[0].Query != "label:bug priority:urgent" {
+		t.Errorf("PutSavedView(existing) = %+v", views)
+	}
+
+	if _, err := RenameSavedView(views, "Mine", "bugs"); err == nil {
+		t.Error("RenameSavedView() to an existing name should fail")
+	}
+	views, err = RenameSavedView(views, "Mine", "Assigned to me")
+	if err != nil {
+		t.Fatalf("RenameSavedView() error: %v", err)
+	}
+	if _, ok := FindSavedView(views, "assigned to me"); !ok {
+		t.Errorf("FindSavedView() did not find renamed view in %+v", views)
+	}
+
+	views, err = DeleteSavedView(views, "Bugs")
+	if err != nil {
+		t.Fatalf("DeleteSavedView() error: %v", err)
+	}
+	if _, ok := DefaultSavedView(views); ok || len(views) != 1 {
+		t.Errorf("DeleteSavedView() = %+v, want one view without a default", views)
+	}
+
+	views, _ = SetDefaultSavedView(views, "Assigned to me")
+	if views, _ = SetDefaultSavedView(views, ""); views[0].Default {
+		t.Error("SetDefaultSavedView(\"\") should clear the default")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 // issueNodeJSON returns a JSON object string for an issue node used in tests.
@@ -281,6 +282,39 @@ func TestFetchIssues_PaginatesAllPages(t *testing.T) {
 	}
 }
 
+// TestFetchIssues_StopsAtLimit verifies that paging stops once the limit is reached.
+func TestFetchIssues_StopsAtLimit(t *testing.T) {
+	requestCount := 0
+	pageOne := issuesPageResponse([]string{
+		issueNodeJSON("issue-1", "ABC-1", "First issue"),
+		issueNodeJSON("issue-2", "ABC-2", "Second issue"),
+	}, true, "cursor-1")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(pageOne))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	issues, err := client.FetchIssues(context.Background(), FetchIssuesParams{First: 2, Limit: 1})
+	if err != nil {
+		t.Fatalf("FetchIssues() error: %v", err)
+	}
+	if requestCount != 1 {
+		t.Errorf("Expected 1 request, got %d", requestCount)
+	}
+	if len(issues) != 1 || issues[0].ID != "issue-1" {
+		t.Errorf("Fetched issues = %+v, want only issue-1", issues)
+	}
+}
+
 // TestFetchIssuesPage_Defaults verifies page defaults and pagination metadata.
 func TestFetchIssuesPage_Defaults(t *testing.T) {
 	var firstValue interface{}
@@ -477,6 +511,35 @@ func TestBuildBaseIssueFilter(t *testing.T) {
 				"state":   map[string]interface{}{"id": map[string]interface{}{"eq": "state-2"}},
 			},
 		},
+		{
+			name:   "state type filter",
+			params: FetchIssuesParams{StateType: "started"},
+			want: IssueFilter{
+				"state": map[string]interface{}{"type": map[string]interface{}{"eq": "started"}},
+			},
+		},
+		{
+			name:   "state id takes precedence over state type",
+			params: FetchIssuesParams{StateID: "state-1", StateType: "started"},
+			want: IssueFilter{
+				"state": map[string]interface{}{"id": map[string]interface{}{"eq": "state-1"}},
+			},
+		},
+		{
+			name:   "cycle filter",
+			params: FetchIssuesParams{TeamID: "team-1", CycleID: "cycle-1"},
+			want: IssueFilter{
+				"team":  map[string]interface{}{"id": map[string]interface{}{"eq": "team-1"}},
+				"cycle": map[string]interface{}{"id": map[string]interface{}{"eq": "cycle-1"}},
+			},
+		},
+		{
+			name:   "updated since filter",
+			params: FetchIssuesParams{UpdatedSince: time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))},
+			want: IssueFilter{
+				"updatedAt": map[string]interface{}{"gte": "2025-01-02T02:04:05Z"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -856,3 +919,495 @@ func TestUpdateIssueInput_ParentID(t *testing.T) {
 		}
 	})
 }
+
+// TestListCycles verifies cycles are parsed and ordered by start date.
+func TestListCycles(t *testing.T) {
+	response := `{
+		"data": {
+			"team": {
+				"cycles": {
+					"nodes": [
+						{"id": "cycle-2", "number": 13, "name": null, "startsAt": "2025-01-15T00:00:00Z", "endsAt": "2025-01-29T00:00:00Z", "isActive": false},
+						{"id": "cycle-1", "number": 12, "name": "Launch", "startsAt": "2025-01-01T00:00:00Z", "endsAt": "2025-01-15T00:00:00Z", "isActive": true}
+					]
+				}
+			}
+		}
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		variables, _ := reqBody["variables"].(map[string]interface{})
+		if variables["teamId"] != "team-1" {
+			t.Errorf("teamId = %v, want team-1", variables["teamId"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	cycles, err := client.ListCycles(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("ListCycles() error: %v", err)
+	}
+	if len(cycles) != 2 {
+		t.Fatalf("len(cycles) = %d, want 2", len(cycles))
+	}
+	if cycles[0].ID != "cycle-1" || cycles[0].Name != "Launch" || !cycles[0].IsActive {
+		t.Errorf("cycles[0] = %+v, want active cycle-1 named Launch", cycles[0])
+	}
+	if cycles[1].Number != 13 || cycles[1].Name != "" || cycles[1].TeamID != "team-1" {
+		t.Errorf("cycles[1] = %+v, want unnamed cycle 13 for team-1", cycles[1])
+	}
+}
+
+// TestFetchIssuesPage_ParsesCycle verifies the issue cycle reference is parsed.
+func TestFetchIssuesPage_ParsesCycle(t *testing.T) {
+	node := strings.Replace(issueNodeJSON("issue-1", "ENG-1", "Task"),
+		`"parent": null`, `"cycle": {"id": "cycle-1", "number": 12, "name": null}, "parent": null`, 1)
+	response := issuesPageResponse([]string{node, issueNodeJSON("issue-2", "ENG-2", "Other")}, false, "")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	page, err := client.FetchIssuesPage(context.Background(), FetchIssuesParams{First: 2}, nil)
+	if err != nil {
+		t.Fatalf("FetchIssuesPage() error: %v", err)
+	}
+	if len(page.Issues) != 2 {
+		t.Fatalf("len(Issues) = %d, want 2", len(page.Issues))
+	}
+	if cycle := page.Issues[0].Cycle; cycle == nil || cycle.ID != "cycle-1" || cycle.Number != 12 {
+		t.Errorf("Issues[0].Cycle = %+v, want cycle-1 #12", cycle)
+	}
+	if page.Issues[1].Cycle != nil {
+		t.Errorf("Issues[1].Cycle = %+v, want nil", page.Issues[1].Cycle)
+	}
+}
+
+func TestRelationTypeFor(t *testing.T) {
+	tests := []struct {
+		apiType string
+		inverse bool
+		want    RelationType
+		wantOK  bool
+	}{
+		{apiType: "blocks", want: RelationBlocks, wantOK: true},
+		{apiType: "blocks", inverse: true, want: RelationBlockedBy, wantOK: true},
+		{apiType: "duplicate", want: RelationDuplicate, wantOK: true},
+		{apiType: "duplicate", inverse: true, want: RelationDuplicatedBy, wantOK: true},
+		{apiType: "related", want: RelationRelated, wantOK: true},
+		{apiType: "related", inverse: true, want: RelationRelated, wantOK: true},
+		{apiType: "similar", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := relationTypeFor(tt.apiType, tt.inverse)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("relationTypeFor(%q, %v) = %q, %v; want %q, %v", tt.apiType, tt.inverse, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+// TestFetchIssueByID_ParsesRelations verifies forward and inverse relations are merged.
+func TestFetchIssueByID_ParsesRelations(t *testing.T) {
+	node := strings.Replace(issueNodeJSON("issue-1", "ENG-1", "Task"), `"parent": null`, `"parent": null,
+		"comments": {"nodes": []},
+		"relations": {"nodes": [
+			{"id": "rel-1", "type": "blocks", "relatedIssue": {"id": "issue-2", "identifier": "ENG-2", "title": "Blocked", "state": {"id": "s", "name": "Todo"}}},
+			{"id": "rel-2", "type": "similar", "relatedIssue": {"id": "issue-5", "identifier": "ENG-5", "title": "Similar", "state": {"id": "s", "name": "Todo"}}}
+		]},
+		"inverseRelations": {"nodes": [
+			{"id": "rel-3", "type": "blocks", "issue": {"id": "issue-3", "identifier": "ENG-3", "title": "Blocker", "state": {"id": "s", "name": "Done"}}}
+		]}`, 1)
+	response := fmt.Sprintf(`{"data": {"issue": %s}}`, node)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	issue, err := client.FetchIssueByID(context.Background(), "issue-1")
+	if err != nil {
+		t.Fatalf("FetchIssueByID() error: %v", err)
+	}
+	if len(issue.Relations) != 2 {
+		t.Fatalf("len(Relations) = %d, want 2", len(issue.Relations))
+	}
+	if rel := issue.Relations[0]; rel.ID != "rel-1" || rel.Type != RelationBlocks || rel.Issue.Identifier != "ENG-2" {
+		t.Errorf("Relations[0] = %+v, want rel-1 blocks ENG-2", rel)
+	}
+	if rel := issue.Relations[1]; rel.ID != "rel-3" || rel.Type != RelationBlockedBy || rel.Issue.State != "Done" {
+		t.Errorf("Relations[1] = %+v, want rel-3 blocked by ENG-3 (Done)", rel)
+	}
+}
+
+// TestCreateIssueRelation_InverseSwapsIssues verifies blocked-by is sent as a swapped blocks relation.
+func TestCreateIssueRelation_InverseSwapsIssues(t *testing.T) {
+	var input map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Variables struct {
+				Input map[string]interface{} `json:"input"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		input = reqBody.Variables.Input
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data": {"issueRelationCreate": {"success": true}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	err := client.CreateIssueRelation(context.Background(), CreateIssueRelationInput{
+		IssueID:        "issue-1",
+		RelatedIssueID: "issue-2",
+		Type:           RelationBlockedBy,
+	})
+	if err != nil {
+		t.Fatalf("CreateIssueRelation() error: %v", err)
+	}
+	if input["issueId"] != "issue-2" || input["relatedIssueId"] != "issue-1" || input["type"] != "blocks" {
+		t.Errorf("input = %v, want issue-2 blocks issue-1", input)
+	}
+
+	if err := client.CreateIssueRelation(context.Background(), CreateIssueRelationInput{Type: "similar"}); err == nil {
+		t.Error("expected error for unsupported relation type")
+	}
+}
+
+// TestFetchIssueByID_ParsesCommentParents verifies replies keep their parent comment ID.
+func TestFetchIssueByID_ParsesCommentParents(t *testing.T) {
+	user := `{"id": "user-1", "name": "alice", "displayName": "Alice", "email": "a@example.com", "isMe": true}`
+	node := strings.Replace(issueNodeJSON("issue-1", "ENG-1", "Task"), `"parent": null`, `"parent": null,
+		"comments": {"nodes": [
+			{"id": "c1", "body": "Question?", "createdAt": "2025-01-01T00:00:00Z", "updatedAt": "2025-01-01T00:00:00Z", "user": `+user+`, "parent": null},
+			{"id": "c2", "body": "Answer", "createdAt": "2025-01-02T00:00:00Z", "updatedAt": "2025-01-02T00:00:00Z", "user": `+user+`, "parent": {"id": "c1"}}
+		]},
+		"relations": {"nodes": []},
+		"inverseRelations": {"nodes": []}`, 1)
+	response := fmt.Sprintf(`{"data": {"issue": %s}}`, node)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	issue, err := client.FetchIssueByID(context.Background(), "issue-1")
+	if err != nil {
+		t.Fatalf("FetchIssueByID() error: %v", err)
+	}
+	if len(issue.Comments) != 2 {
+		t.Fatalf("len(Comments) = %d, want 2", len(issue.Comments))
+	}
+	if issue.Comments[0].ParentID != "" || issue.Comments[1].ParentID != "c1" {
+		t.Errorf("ParentIDs = %q, %q; want \"\", c1", issue.Comments[0].ParentID, issue.Comments[1].ParentID)
+	}
+}
+
+// TestUpdateAndDeleteComment verifies comment mutations send the comment ID and body.
+func TestUpdateAndDeleteComment(t *testing.T) {
+	var variables map[string]interface{}
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		variables = reqBody.Variables
+		queries = append(queries, reqBody.Query)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if strings.Contains(reqBody.Query, "commentDelete") {
+			_, _ = w.Write([]byte(`{"data": {"commentDelete": {"success": true}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"commentUpdate": {"success": true, "comment": {"id": "c1", "body": "edited", "createdAt": "2025-01-01T00:00:00Z", "updatedAt": "2025-01-03T00:00:00Z"}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	comment, err := client.UpdateComment(context.Background(), "c1", "edited")
+	if err != nil {
+		t.Fatalf("UpdateComment() error: %v", err)
+	}
+	if comment.Body != "edited" || comment.UpdatedAt.IsZero() {
+		t.Errorf("UpdateComment() = %+v, want edited body", comment)
+	}
+	input, _ := variables["input"].(map[string]interface{})
+	if variables["id"] != "c1" || input["body"] != "edited" {
+		t.Errorf("variables = %v, want id c1 and body", variables)
+	}
+
+	if err := client.DeleteComment(context.Background(), "c1"); err != nil {
+		t.Fatalf("DeleteComment() error: %v", err)
+	}
+	if variables["id"] != "c1" || !strings.Contains(queries[len(queries)-1], "commentDelete(id: $id)") {
+		t.Errorf("DeleteComment() sent %q with %v", queries[len(queries)-1], variables)
+	}
+}
+
+// TestListNotifications verifies issue notifications are parsed and sorted newest first.
+func TestListNotifications(t *testing.T) {
+	response := `{"data": {"notifications": {"nodes": [
+		{"id": "n1", "type": "issueAssignedToYou", "createdAt": "2025-01-01T00:00:00Z", "readAt": "2025-01-02T00:00:00Z", "snoozedUntilAt": null,
+		 "actor": {"name": "Alice Smith", "displayName": "alice"}, "issue": {"id": "issue-1", "identifier": "ENG-1", "title": "First"}},
+		{"id": "n2", "type": "projectUpdateCreated", "createdAt": "2025-01-03T00:00:00Z", "readAt": null, "snoozedUntilAt": null, "actor": null},
+		{"id": "n3", "type": "issueMention", "createdAt": "2025-01-04T00:00:00Z", "readAt": null, "snoozedUntilAt": "2025-02-01T09:00:00Z",
+		 "actor": null, "issue": {"id": "issue-2", "identifier": "ENG-2", "title": "Second"}}
+	]}}}`
+
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Query string `json:"query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		query = reqBody.Query
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+
+	notifications, err := client.ListNotifications(context.Background())
+	if err != nil {
+		t.Fatalf("ListNotifications() error: %v", err)
+	}
+	if !strings.Contains(query, "... on IssueNotification") {
+		t.Errorf("query = %q, want IssueNotification fragment", query)
+	}
+	if len(notifications) != 2 {
+		t.Fatalf("len(notifications) = %d, want 2", len(notifications))
+	}
+
+	first, second := notifications[0], notifications[1]
+	if first.ID != "n3" || first.IssueIdentifier != "ENG-2" || !first.Unread() || first.SnoozedUntil.IsZero() {
+		t.Errorf("notifications[0] = %+v, want unread snoozed n3", first)
+	}
+	if second.ID != "n1" || second.Actor != "alice" || second.IssueID != "issue-1" || second.Unread() {
+		t.Errorf("notifications[1] = %+v, want read n1 by alice", second)
+	}
+}
+
+// TestNotificationMutations verifies read state, snooze, and archive requests.
+func TestNotificationMutations(t *testing.T) {
+	var variables map[string]interface{}
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		variables = reqBody.Variables
+		queries = append(queries, reqBody.Query)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if strings.Contains(reqBody.Query, "notificationArchive") {
+			_, _ = w.Write([]byte(`{"data": {"notificationArchive": {"success": true}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"notificationUpdate": {"success": true}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "test-token",
+		Endpoint: server.URL,
+	})
+	ctx := context.Background()
+
+	if err := client.MarkNotificationRead(ctx, "n1", false); err != nil {
+		t.Fatalf("MarkNotificationRead() error: %v", err)
+	}
+	input, _ := variables["input"].(map[string]interface{})
+	if readAt, ok := input["readAt"]; !ok || readAt != nil || variables["id"] != "n1" {
+		t.Errorf("unread variables = %v, want readAt null", variables)
+	}
+
+	if err := client.MarkNotificationRead(ctx, "n1", true); err != nil {
+		t.Fatalf("MarkNotificationRead() error: %v", err)
+	}
+	input, _ = variables["input"].(map[string]interface{})
+	if readAt, _ := input["readAt"].(string); readAt == "" {
+		t.Errorf("read variables = %v, want readAt timestamp", variables)
+	}
+
+	until := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+	if err := client.SnoozeNotification(ctx, "n1", until); err != nil {
+		t.Fatalf("SnoozeNotification() error: %v", err)
+	}
+	input, _ = variables["input"].(map[string]interface{})
+	if input["snoozedUntilAt"] != "2025-02-01T09:00:00Z" {
+		t.Errorf("snooze variables = %v", variables)
+	}
+
+	if err := client.ArchiveNotification(ctx, "n1"); err != nil {
+		t.Fatalf("ArchiveNotification() error: %v", err)
+	}
+	if variables["id"] != "n1" || !strings.Contains(queries[len(queries)-1], "notificationArchive(id: $id)") {
+		t.Errorf("ArchiveNotification() sent %q with %v", queries[len(queries)-1], variables)
+	}
+}
+
+func TestClient_RefreshesTokenAfterUnauthorized(t *testing.T) {
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "fresh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"teams": {"nodes": [{"id": "t1", "key": "ENG", "name": "Engineering"}]}}}`))
+	}))
+	defer server.Close()
+
+	refreshes := 0
+	client := NewClient(ClientConfig{
+		Token:    "stale-token",
+		Endpoint: server.URL,
+		RefreshToken: func(ctx context.Context) (string, error) {
+			refreshes++
+			return "fresh-token", nil
+		},
+	})
+
+	teams, err := client.ListTeams(context.Background())
+	if err != nil {
+		t.Fatalf("ListTeams() error: %v", err)
+	}
+	if len(teams) != 1 || teams[0].Key != "ENG" {
+		t.Errorf("ListTeams() = %+v", teams)
+	}
+	if _, err := client.ListTeams(context.Background()); err != nil {
+		t.Fatalf("second ListTeams() error: %v", err)
+	}
+
+	// The refreshed token is kept for later requests
+	want := []string{"stale-token", "fresh-token", "fresh-token"}
+	if !reflect.DeepEqual(authHeaders, want) || refreshes != 1 {
+		t.Errorf("Authorization headers = %v after %d refreshes, want %v after 1", authHeaders, refreshes, want)
+	}
+}
+
+func TestClient_ReportsTokenRefreshFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		Token:    "stale-token",
+		Endpoint: server.URL,
+		RefreshToken: func(ctx context.Context) (string, error) {
+			return "", fmt.Errorf("api_key_command failed: exit status 1")
+		},
+	})
+
+	_, err := client.ListTeams(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "refresh API key: api_key_command failed") {
+		t.Errorf("ListTeams() error = %v, want refresh failure", err)
+	}
+}
+
+// fakeTokenSource issues numbered tokens and counts refreshes.
+type fakeTokenSource struct {
+	current   string
+	refreshes int
+}
+
+func (f *fakeTokenSource) Token(ctx context.Context) (string, error) {
+	return f.current, nil
+}
+
+func (f *fakeTokenSource) Refresh(ctx context.Context, stale string) (string, error) {
+	if stale == f.current {
+		f.refreshes++
+		f.current = fmt.Sprintf("Bearer access-%d", f.refreshes+1)
+	}
+	return f.current, nil
+}
+
+func TestClient_UsesTokenSource(t *testing.T) {
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer access-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"teams": {"nodes": []}}}`))
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{current: "Bearer access-1"}
+	client := NewClient(ClientConfig{
+		Token:       "unused-api-key",
+		Endpoint:    server.URL,
+		TokenSource: source,
+	})
+
+	if _, err := client.ListTeams(context.Background()); err != nil {
+		t.Fatalf("ListTeams() error: %v", err)
+	}
+	want := []string{"Bearer access-1", "Bearer access-2"}
+	if !reflect.DeepEqual(authHeaders, want) || source.refreshes != 1 {
+		t.Errorf("Authorization headers = %v after %d refreshes, want %v after 1", authHeaders, source.refreshes, want)
+	}
+}
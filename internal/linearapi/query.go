@@ -0,0 +1,465 @@
+package linearapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Query fields supported by ParseIssueQuery.
+const (
+	QueryFieldAssignee = "assignee"
+	QueryFieldState    = "state"
+	QueryFieldLabel    = "label"
+	QueryFieldPriority = "priority"
+	QueryFieldProject  = "project"
+	QueryFieldTeam     = "team"
+	QueryFieldUpdated  = "updated"
+	QueryFieldCreated  = "created"
+)
+
+// QueryFields lists the field names accepted in issue queries, in the order
+// they are suggested.
+var QueryFields = []string{
+	QueryFieldAssignee,
+	QueryFieldState,
+	QueryFieldLabel,
+	QueryFieldPriority,
+	QueryFieldProject,
+	QueryFieldTeam,
+	QueryFieldUpdated,
+	QueryFieldCreated,
+}
+
+// PriorityNames maps priority names accepted in queries to Linear priority values.
+var PriorityNames = map[string]int{
+	"none":   0,
+	"urgent": 1,
+	"high":   2,
+	"normal": 3,
+	"medium": 3,
+	"low":    4,
+}
+
+// Comparison operators for query terms.
+const (
+	QueryOpEq  = "="
+	QueryOpGt  = ">"
+	QueryOpGte = ">="
+	QueryOpLt  = "<"
+	QueryOpLte = "<="
+
+	// queryOpNeq is only produced by negating an equality term.
+	queryOpNeq = "!="
+)
+
+// IssueQuery is a parsed search query: structured field terms plus any
+// remaining free text for full-text search.
+type IssueQuery struct {
+	Text  string
+	Terms []QueryTerm
+}
+
+// QueryTerm is a single field condition such as `label:bug` or `-state:Done`.
+type QueryTerm struct {
+	Field   string
+	Op      string
+	Value   string
+	Negated bool
+	// Priority is the numeric value for priority terms.
+	Priority int
+	// From and To bound updated/created terms; a zero value means unbounded.
+	From time.Time
+	To   time.Time
+}
+
+// QuerySyntaxError describes an invalid issue query.
+type QuerySyntaxError struct {
+	Offset  int // Byte offset of the offending term in the query
+	Message string
+}
+
+// Error implements error.
+func (e *QuerySyntaxError) Error() string {
+	return fmt.Sprintf("%s (at column %d)", e.Message, e.Offset+1)
+}
+
+// queryToken is a whitespace-separated query token with quotes removed.
+type queryToken struct {
+	Offset int
+	Text   string
+	Quoted bool // The whole token was quoted, so it is free text
+}
+
+// ParseIssueQuery parses a search query such as
+// `assignee:me state:"In Progress" -label:wontfix updated:<7d crash` into
+// field terms and free text. Relative dates are resolved against now.
+func ParseIssueQuery(input string, now time.Time) (IssueQuery, error) {
+	tokens, err := tokenizeQuery(input)
+	if err != nil {
+		return IssueQuery{}, err
+	}
+
+	var query IssueQuery
+	var text []string
+	for _, token := range tokens {
+		field, value, ok := splitQueryTerm(token)
+		if !ok {
+			text = append(text, token.Text)
+			continue
+		}
+		term, err := parseQueryTerm(field, value, now)
+		if err != nil {
+			return IssueQuery{}, &QuerySyntaxError{Offset: token.Offset, Message: err.Error()}
+		}
+		query.Terms = append(query.Terms, term)
+	}
+	query.Text = strings.Join(text, " ")
+	return query, nil
+}
+
+// tokenizeQuery splits a query on whitespace, keeping quoted values together.
+func tokenizeQuery(input string) ([]queryToken, error) {
+	var tokens []queryToken
+	var current strings.Builder
+	start := -1
+	quoteStart := -1
+	quoted := false
+
+	flush := func() {
+		if start >= 0 {
+			tokens = append(tokens, queryToken{Offset: start, Text: current.String(), Quoted: quoted})
+		}
+		current.Reset()
+		start = -1
+		quoted = false
+	}
+
+	for i, r := range input {
+		switch {
+		case r == '"':
+			if start < 0 {
+				start = i
+				quoted = true
+			}
+			if quoteStart >= 0 {
+				quoteStart = -1
+			} else {
+				quoteStart = i
+			}
+		case unicode.IsSpace(r) && quoteStart < 0:
+			flush()
+		default:
+			if start < 0 {
+				start = i
+			}
+			current.WriteRune(r)
+		}
+	}
+	if quoteStart >= 0 {
+		return nil, &QuerySyntaxError{Offset: quoteStart, Message: "unterminated quote"}
+	}
+	flush()
+	return tokens, nil
+}
+
+// splitQueryTerm splits `field:value` or `-field:value` tokens of a supported
+// field. Other tokens, such as `TODO:fix` or URLs, are free text.
+func splitQueryTerm(token queryToken) (string, string, bool) {
+	if token.Quoted {
+		return "", "", false
+	}
+	field, value, ok := strings.Cut(token.Text, ":")
+	if !ok {
+		return "", "", false
+	}
+	field = strings.ToLower(field)
+	if !isQueryField(strings.TrimPrefix(field, "-")) {
+		return "", "", false
+	}
+	return field, value, true
+}
+
+// parseQueryTerm validates a field term and resolves its value.
+func parseQueryTerm(field, value string, now time.Time) (QueryTerm, error) {
+	term := QueryTerm{Field: strings.TrimPrefix(field, "-"), Negated: strings.HasPrefix(field, "-")}
+	term.Op, value = splitQueryOp(value)
+	if strings.TrimSpace(value) == "" {
+		return QueryTerm{}, fmt.Errorf("missing value for %s", term.Field)
+	}
+	term.Value = value
+
+	switch term.Field {
+	case QueryFieldPriority:
+		priority, err := parseQueryPriority(value)
+		if err != nil {
+			return QueryTerm{}, err
+		}
+		term.Priority = priority
+	case QueryFieldUpdated, QueryFieldCreated:
+		from, to, err := parseQueryTime(term.Op, value, now)
+		if err != nil {
+			return QueryTerm{}, fmt.Errorf("%s: %w", term.Field, err)
+		}
+		term.From, term.To = from, to
+	default:
+		if term.Op != QueryOpEq {
+			return QueryTerm{}, fmt.Errorf("%s does not support %s", term.Field, term.Op)
+		}
+	}
+	return term, nil
+}
+
+// isQueryField reports whether name is a supported query field.
+func isQueryField(name string) bool {
+	for _, field := range QueryFields {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
+
+// splitQueryOp splits a leading comparison operator off a value.
+func splitQueryOp(value string) (string, string) {
+	for _, op := range []string{QueryOpGte, QueryOpLte, QueryOpGt, QueryOpLt, QueryOpEq} {
+		if strings.HasPrefix(value, op) {
+			return op, value[len(op):]
+		}
+	}
+	return QueryOpEq, value
+}
+
+// parseQueryPriority parses a priority number (0-4) or name.
+func parseQueryPriority(value string) (int, error) {
+	if priority, ok := PriorityNames[strings.ToLower(value)]; ok {
+		return priority, nil
+	}
+	priority, err := strconv.Atoi(value)
+	if err != nil || priority < 0 || priority > 4 {
+		return 0, fmt.Errorf("invalid priority %q: use 0-4 or none, urgent, high, normal, low", value)
+	}
+	return priority, nil
+}
+
+// parseQueryTime resolves a date comparison to a time range. Durations such as
+// 7d, 2w, or 12h are ages, so `<7d` means "within the last 7 days". Dates
+// (YYYY-MM-DD) compare by calendar day, so `>2025-01-31` means "after that day".
+func parseQueryTime(op, value string, now time.Time) (time.Time, time.Time, error) {
+	if age, ok := parseQueryAge(value); ok {
+		at := now.Add(-age)
+		switch op {
+		case QueryOpLt, QueryOpLte, QueryOpEq:
+			return at, time.Time{}, nil
+		default:
+			return time.Time{}, at, nil
+		}
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", value, now.Location())
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q: use a duration like 7d or a date like 2025-01-31", value)
+	}
+	next := day.AddDate(0, 0, 1)
+	switch op {
+	case QueryOpGt:
+		return next, time.Time{}, nil
+	case QueryOpGte:
+		return day, time.Time{}, nil
+	case QueryOpLt:
+		return time.Time{}, day, nil
+	case QueryOpLte:
+		return time.Time{}, next, nil
+	default:
+		return day, next, nil
+	}
+}
+
+// parseQueryAge parses durations with h (hours), d (days), or w (weeks) units.
+func parseQueryAge(value string) (time.Duration, bool) {
+	if len(value) < 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	switch value[len(value)-1] {
+	case 'h':
+		return time.Duration(n) * time.Hour, true
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour, true
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour, true
+	}
+	return 0, false
+}
+
+// IsEmpty reports whether the query has neither terms nor free text.
+func (q IssueQuery) IsEmpty() bool {
+	return len(q.Terms) == 0 && strings.TrimSpace(q.Text) == ""
+}
+
+// conditions returns one IssueFilter condition per term, to be combined with "and".
+func (q IssueQuery) conditions() []map[string]interface{} {
+	conditions := make([]map[string]interface{}, 0, len(q.Terms))
+	for _, term := range q.Terms {
+		conditions = append(conditions, term.condition())
+	}
+	return conditions
+}
+
+// condition builds the IssueFilter condition for a term.
+func (t QueryTerm) condition() map[string]interface{} {
+	switch t.Field {
+	case QueryFieldAssignee:
+		return assigneeCondition(t.Value, t.Negated)
+	case QueryFieldState:
+		return map[string]interface{}{"state": map[string]interface{}{"name": ignoreCaseComparator(t.Value, t.Negated)}}
+	case QueryFieldLabel:
+		match := "some"
+		if t.Negated {
+			match = "none"
+		}
+		return map[string]interface{}{"labels": map[string]interface{}{
+			match: map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": t.Value}},
+		}}
+	case QueryFieldPriority:
+		op := t.Op
+		if t.Negated {
+			op = negateQueryOp(op)
+		}
+		return map[string]interface{}{"priority": map[string]interface{}{numberComparator(op): t.Priority}}
+	case QueryFieldProject:
+		return nullableNameCondition("project", t.Value, t.Negated)
+	case QueryFieldTeam:
+		return map[string]interface{}{"team": teamCondition(t.Value, t.Negated)}
+	case QueryFieldUpdated, QueryFieldCreated:
+		return dateCondition(t.Field+"At", t.From, t.To, t.Negated)
+	}
+	return map[string]interface{}{}
+}
+
+// ignoreCaseComparator matches a string exactly, ignoring case.
+func ignoreCaseComparator(value string, negated bool) map[string]interface{} {
+	if negated {
+		return map[string]interface{}{"neqIgnoreCase": value}
+	}
+	return map[string]interface{}{"eqIgnoreCase": value}
+}
+
+// assigneeCondition handles assignee:me, assignee:none, and assignee names.
+// Negated terms also match unassigned issues.
+func assigneeCondition(value string, negated bool) map[string]interface{} {
+	switch strings.ToLower(value) {
+	case "none":
+		return map[string]interface{}{"assignee": map[string]interface{}{"null": !negated}}
+	case "me":
+		if !negated {
+			return map[string]interface{}{"assignee": map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}}
+		}
+		return orNull("assignee", map[string]interface{}{"isMe": map[string]interface{}{"eq": false}})
+	}
+	if !negated {
+		return map[string]interface{}{"assignee": map[string]interface{}{"name": map[string]interface{}{"containsIgnoreCase": value}}}
+	}
+	return orNull("assignee", map[string]interface{}{"name": map[string]interface{}{"notContainsIgnoreCase": value}})
+}
+
+// nullableNameCondition matches an optional relation by name, or by its
+// absence for "none". Negated terms also match issues without the relation.
+func nullableNameCondition(relation, value string, negated bool) map[string]interface{} {
+	if strings.EqualFold(value, "none") {
+		return map[string]interface{}{relation: map[string]interface{}{"null": !negated}}
+	}
+	if !negated {
+		return map[string]interface{}{relation: map[string]interface{}{"name": ignoreCaseComparator(value, false)}}
+	}
+	return orNull(relation, map[string]interface{}{"name": ignoreCaseComparator(value, true)})
+}
+
+// orNull matches issues where relation is unset or matches filter.
+func orNull(relation string, filter map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"or": []map[string]interface{}{
+		{relation: map[string]interface{}{"null": true}},
+		{relation: filter},
+	}}
+}
+
+// teamCondition matches a team by key or name.
+func teamCondition(value string, negated bool) map[string]interface{} {
+	if negated {
+		return map[string]interface{}{
+			"key":  ignoreCaseComparator(value, true),
+			"name": ignoreCaseComparator(value, true),
+		}
+	}
+	return map[string]interface{}{"or": []map[string]interface{}{
+		{"key": ignoreCaseComparator(value, false)},
+		{"name": ignoreCaseComparator(value, false)},
+	}}
+}
+
+// dateCondition matches a timestamp field within [from, to). Negated terms
+// match timestamps outside the range.
+func dateCondition(field string, from, to time.Time, negated bool) map[string]interface{} {
+	format := func(t time.Time) string { return t.UTC().Format(time.RFC3339) }
+	if !negated {
+		comparator := make(map[string]interface{})
+		if !from.IsZero() {
+			comparator["gte"] = format(from)
+		}
+		if !to.IsZero() {
+			comparator["lt"] = format(to)
+		}
+		return map[string]interface{}{field: comparator}
+	}
+
+	var outside []map[string]interface{}
+	if !from.IsZero() {
+		outside = append(outside, map[string]interface{}{field: map[string]interface{}{"lt": format(from)}})
+	}
+	if !to.IsZero() {
+		outside = append(outside, map[string]interface{}{field: map[string]interface{}{"gte": format(to)}})
+	}
+	if len(outside) == 1 {
+		return outside[0]
+	}
+	return map[string]interface{}{"or": outside}
+}
+
+// numberComparator returns the NumberComparator key for an operator.
+func numberComparator(op string) string {
+	switch op {
+	case QueryOpGt:
+		return "gt"
+	case QueryOpGte:
+		return "gte"
+	case QueryOpLt:
+		return "lt"
+	case QueryOpLte:
+		return "lte"
+	case queryOpNeq:
+		return "neq"
+	default:
+		return "eq"
+	}
+}
+
+// negateQueryOp returns the operator matching the complement of op.
+func negateQueryOp(op string) string {
+	switch op {
+	case QueryOpGt:
+		return QueryOpLte
+	case QueryOpGte:
+		return QueryOpLt
+	case QueryOpLt:
+		return QueryOpGte
+	case QueryOpLte:
+		return QueryOpGt
+	default:
+		return queryOpNeq
+	}
+}
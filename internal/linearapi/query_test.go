@@ -0,0 +1,182 @@
+package linearapi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseIssueQuery(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	query, err := ParseIssueQuery(`assignee:me state:"In Progress" label:bug priority:>=2 updated:<7d project:Infra -label:wontfix crash on start`, now)
+	if err != nil {
+		t.Fatalf("ParseIssueQuery() error = %v", err)
+	}
+	if query.Text != "crash on start" {
+		t.Errorf("Text = %q, want %q", query.Text, "crash on start")
+	}
+
+	want := []map[string]interface{}{
+		{"assignee": map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}},
+		{"state": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": "In Progress"}}},
+		{"labels": map[string]interface{}{"some": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": "bug"}}}},
+		{"priority": map[string]interface{}{"gte": 2}},
+		{"updatedAt": map[string]interface{}{"gte": "2025-03-03T12:00:00Z"}},
+		{"project": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": "Infra"}}},
+		{"labels": map[string]interface{}{"none": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": "wontfix"}}}},
+	}
+	if got := query.conditions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("conditions() =\n%#v\nwant\n%#v", got, want)
+	}
+}
+
+func TestParseIssueQueryTerms(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		query string
+		want  map[string]interface{}
+	}{
+		{
+			name:  "priority by name",
+			query: "priority:urgent",
+			want:  map[string]interface{}{"priority": map[string]interface{}{"eq": 1}},
+		},
+		{
+			name:  "negated priority comparison",
+			query: "-priority:<3",
+			want:  map[string]interface{}{"priority": map[string]interface{}{"gte": 3}},
+		},
+		{
+			name:  "unassigned",
+			query: "assignee:none",
+			want:  map[string]interface{}{"assignee": map[string]interface{}{"null": true}},
+		},
+		{
+			name:  "not assigned to me includes unassigned",
+			query: "-assignee:me",
+			want: map[string]interface{}{"or": []map[string]interface{}{
+				{"assignee": map[string]interface{}{"null": true}},
+				{"assignee": map[string]interface{}{"isMe": map[string]interface{}{"eq": false}}},
+			}},
+		},
+		{
+			name:  "older than two weeks",
+			query: "created:>2w",
+			want:  map[string]interface{}{"createdAt": map[string]interface{}{"lt": "2025-02-24T12:00:00Z"}},
+		},
+		{
+			name:  "on a day",
+			query: "updated:2025-01-31",
+			want: map[string]interface{}{"updatedAt": map[string]interface{}{
+				"gte": "2025-01-31T00:00:00Z",
+				"lt":  "2025-02-01T00:00:00Z",
+			}},
+		},
+		{
+			name:  "negated day",
+			query: "-updated:2025-01-31",
+			want: map[string]interface{}{"or": []map[string]interface{}{
+				{"updatedAt": map[string]interface{}{"lt": "2025-01-31T00:00:00Z"}},
+				{"updatedAt": map[string]interface{}{"gte": "2025-02-01T00:00:00Z"}},
+			}},
+		},
+		{
+			name:  "team by key or name",
+			query: "TEAM:eng",
+			want: map[string]interface{}{"team": map[string]interface{}{"or": []map[string]interface{}{
+				{"key": map[string]interface{}{"eqIgnoreCase": "eng"}},
+				{"name": map[string]interface{}{"eqIgnoreCase": "eng"}},
+			}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := ParseIssueQuery(tt.query, now)
+			if err != nil {
+				t.Fatalf("ParseIssueQuery(%q) error = %v", tt.query, err)
+			}
+			got := query.conditions()
+			if len(got) != 1 || !reflect.DeepEqual(got[0], tt.want) {
+				t.Errorf("conditions() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseIssueQueryFreeText(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: `"state:done" 10:30 ENG-12`, want: "state:done 10:30 ENG-12"},
+		{query: "error: timeout", want: "error: timeout"},
+		{query: "TODO:fix", want: "TODO:fix"},
+		{query: "https://linear.app/acme/issue/ENG-12", want: "https://linear.app/acme/issue/ENG-12"},
+		{query: "bug stauts:done", want: "bug stauts:done"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := ParseIssueQuery(tt.query, time.Now())
+			if err != nil {
+				t.Fatalf("ParseIssueQuery(%q) error = %v", tt.query, err)
+			}
+			if len(query.Terms) != 0 {
+				t.Errorf("Terms = %v, want none", query.Terms)
+			}
+			if query.Text != tt.want {
+				t.Errorf("Text = %q, want %q", query.Text, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseIssueQueryErrors(t *testing.T) {
+	tests := []struct {
+		query  string
+		offset int
+	}{
+		{query: "state:", offset: 0},
+		{query: `label:bug state:"In Progress`, offset: 16},
+		{query: "priority:5", offset: 0},
+		{query: "label:>bug", offset: 0},
+		{query: "updated:<soon", offset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := ParseIssueQuery(tt.query, time.Now())
+			var syntaxErr *QuerySyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParseIssueQuery(%q) error = %v, want QuerySyntaxError", tt.query, err)
+			}
+			if syntaxErr.Offset != tt.offset {
+				t.Errorf("Offset = %d, want %d (%v)", syntaxErr.Offset, tt.offset, err)
+			}
+		})
+	}
+}
+
+func TestBuildIssueFilterWithQuery(t *testing.T) {
+	query, err := ParseIssueQuery("label:bug", time.Now())
+	if err != nil {
+		t.Fatalf("ParseIssueQuery() error = %v", err)
+	}
+	got := buildIssueFilter(FetchIssuesParams{TeamID: "team-1", Search: "login crash", Query: query})
+
+	want := IssueFilter{
+		"team": map[string]interface{}{"id": map[string]interface{}{"eq": "team-1"}},
+		"and": []map[string]interface{}{
+			{"labels": map[string]interface{}{"some": map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": "bug"}}}},
+			{"or": buildSearchOrFilters("login")},
+			{"or": buildSearchOrFilters("crash")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("buildIssueFilter() = %#v, want %#v", got, want)
+	}
+}
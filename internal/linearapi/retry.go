@@ -0,0 +1,267 @@
+package linearapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+const (
+	// defaultMaxRetries is the number of retries for rate-limited or 5xx responses.
+	defaultMaxRetries = 3
+	// defaultRetryBaseDelay is the backoff before the first retry; it doubles per attempt.
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	// defaultRetryMaxDelay caps a single backoff and the longest rate-limit reset
+	// worth waiting for. Longer resets are returned as RateLimitedError immediately.
+	defaultRetryMaxDelay = 30 * time.Second
+
+	// rateLimitedCode is the GraphQL error code Linear uses for rate limiting.
+	rateLimitedCode = "RATELIMITED"
+)
+
+// rateLimitBuckets are the prefixes of Linear's rate-limit response headers.
+// Each bucket has -Remaining and -Reset headers; reset values are UTC epoch milliseconds.
+var rateLimitBuckets = []string{
+	"X-RateLimit-Requests",
+	"X-RateLimit-Complexity",
+}
+
+// RateLimitedError is returned when the Linear API keeps rejecting requests
+// because the rate limit was exceeded.
+type RateLimitedError struct {
+	// ResetAt is when the rate limit window resets (zero if unknown).
+	ResetAt time.Time
+}
+
+// Error implements error.
+func (e *RateLimitedError) Error() string {
+	if e.ResetAt.IsZero() {
+		return "rate limited by Linear API"
+	}
+	return fmt.Sprintf("rate limited by Linear API until %s", e.ResetAt.Local().Format(time.Kitchen))
+}
+
+// RetryAfter returns how long until the rate limit resets, relative to now.
+func (e *RateLimitedError) RetryAfter(now time.Time) time.Duration {
+	if e.ResetAt.IsZero() || !e.ResetAt.After(now) {
+		return 0
+	}
+	return e.ResetAt.Sub(now)
+}
+
+// retryTransport retries requests that were rate limited or failed with a
+// transient 5xx response, using exponential backoff with jitter. A mutation
+// may already have been applied when the server answers 500, 502, or 504, so
+// mutations are only retried when rate limited or on a 503 with Retry-After.
+type retryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// newRetryTransport wraps base with the default backoff settings.
+func newRetryTransport(base http.RoundTripper, maxRetries int) *retryTransport {
+	return &retryTransport{
+		Base:       base,
+		MaxRetries: maxRetries,
+		BaseDelay:  defaultRetryBaseDelay,
+		MaxDelay:   defaultRetryMaxDelay,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	idempotent := isIdempotent(req)
+	for attempt := 0; ; attempt++ {
+		resp, err := t.Base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		rateLimited, err := isRateLimited(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !rateLimited && !isTransientStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		if !rateLimited && !idempotent && !isUnavailableWithRetryAfter(resp) {
+			return resp, nil
+		}
+
+		now := time.Now()
+		resetAt := rateLimitResetAt(resp.Header, now)
+		canRetry := attempt < t.MaxRetries && (req.Body == nil || req.GetBody != nil)
+
+		delay := t.backoff(attempt)
+		if rateLimited && !resetAt.IsZero() {
+			delay = resetAt.Sub(now)
+			if delay > t.MaxDelay {
+				canRetry = false
+			}
+		}
+		// Don't start a wait that would outlast the request deadline
+		if deadline, ok := req.Context().Deadline(); ok && now.Add(delay).After(deadline) {
+			canRetry = false
+		}
+
+		if !canRetry {
+			if rateLimited {
+				_ = resp.Body.Close()
+				logger.Warning("linearapi.client: rate limited, giving up attempts=%d reset_at=%s", attempt+1, resetAt.Format(time.RFC3339))
+				return nil, &RateLimitedError{ResetAt: resetAt}
+			}
+			return resp, nil
+		}
+		_ = resp.Body.Close()
+
+		logger.Warning("linearapi.client: retrying request status=%d rate_limited=%v attempt=%d delay=%s", resp.StatusCode, rateLimited, attempt+1, delay)
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("rewind request body: %w", err)
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns the jittered exponential backoff for a retry attempt.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := t.BaseDelay << attempt
+	if delay <= 0 || delay > t.MaxDelay {
+		delay = t.MaxDelay
+	}
+	// Jitter in [delay/2, delay] so concurrent clients don't retry in lockstep
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// isRateLimited reports whether resp is a rate-limit rejection. Linear answers
+// with HTTP 429 or with a RATELIMITED GraphQL error code, so non-2xx bodies are
+// buffered and inspected; the body is restored for the caller.
+func isRateLimited(resp *http.Response) (bool, error) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return false, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return false, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return bytes.Contains(body, []byte(rateLimitedCode)), nil
+}
+
+// isTransientStatus reports whether a status code is worth retrying.
+func isTransientStatus(status int) bool {
+	switch status {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isUnavailableWithRetryAfter reports whether resp is a 503 telling the client
+// when to retry, which means the request was not processed.
+func isUnavailableWithRetryAfter(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != ""
+}
+
+// isIdempotent reports whether req can safely be sent again after a 5xx
+// response: GraphQL queries and requests carrying an Idempotency-Key header
+// are, mutations are not.
+func isIdempotent(req *http.Request) bool {
+	if req.Header.Get("Idempotency-Key") != "" || req.Header.Get("X-Idempotency-Key") != "" {
+		return true
+	}
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return true
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	defer body.Close()
+	var payload struct {
+		Query string `json:"query"`
+	}
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		return false
+	}
+	return !strings.HasPrefix(strings.TrimSpace(payload.Query), "mutation")
+}
+
+// rateLimitResetAt returns when the rate limit resets according to the
+// response headers, or the zero time if the headers don't say. Linear sends
+// reset headers for every bucket, so only exhausted buckets are considered.
+func rateLimitResetAt(header http.Header, now time.Time) time.Time {
+	var resetAt time.Time
+	for _, bucket := range rateLimitBuckets {
+		remaining, err := strconv.ParseInt(header.Get(bucket+"-Remaining"), 10, 64)
+		if err != nil || remaining > 0 {
+			continue
+		}
+		millis, err := strconv.ParseInt(header.Get(bucket+"-Reset"), 10, 64)
+		if err != nil || millis <= 0 {
+			continue
+		}
+		if reset := time.UnixMilli(millis); reset.After(resetAt) {
+			resetAt = reset
+		}
+	}
+	if !resetAt.IsZero() {
+		return resetAt
+	}
+
+	retryAfter := header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return now.Add(time.Duration(seconds) * time.Second)
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		return date
+	}
+	return time.Time{}
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
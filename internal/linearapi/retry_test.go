@@ -0,0 +1,254 @@
+package linearapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestRetryTransport returns a retry transport with short delays for tests.
+func newTestRetryTransport(maxRetries int) *retryTransport {
+	return &retryTransport{
+		Base:       http.DefaultTransport,
+		MaxRetries: maxRetries,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+	}
+}
+
+func TestRetryTransport_RetriesTransientErrors(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"query":"q"}`))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	resp, err := newTestRetryTransport(3).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Errorf("status = %d after %d calls, want 200 after 3", resp.StatusCode, calls.Load())
+	}
+	for i, body := range bodies {
+		if body != `{"query":"q"}` {
+			t.Errorf("attempt %d body = %q, want original body", i+1, body)
+		}
+	}
+}
+
+func TestRetryTransport_ReturnsLastTransientResponse(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+	resp, err := newTestRetryTransport(2).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || calls.Load() != 3 {
+		t.Errorf("status = %d after %d calls, want 502 after 3", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryTransport_MutationsOnlyRetryWhenNotProcessed(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+		wantCalls  int32
+	}{
+		{name: "bad_gateway", status: http.StatusBadGateway, wantCalls: 1},
+		{name: "gateway_timeout", status: http.StatusGatewayTimeout, wantCalls: 1},
+		{name: "unavailable", status: http.StatusServiceUnavailable, wantCalls: 1},
+		{name: "unavailable_retry_after", status: http.StatusServiceUnavailable, retryAfter: "0", wantCalls: 3},
+		{name: "rate_limited", status: http.StatusTooManyRequests, retryAfter: "0", wantCalls: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) < 3 {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data": {}}`))
+			}))
+			defer server.Close()
+
+			body := `{"query":"mutation($input:CommentCreateInput!){commentCreate(input: $input){success}}"}`
+			req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+			resp, err := newTestRetryTransport(3).RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip() error = %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls.Load(), tt.wantCalls)
+			}
+		})
+	}
+
+	// Marked idempotent, a mutation is retried like a query
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"query":"mutation{issueArchive(id: \"1\"){success}}"}`))
+	req.Header.Set("Idempotency-Key", "key-1")
+	resp, err := newTestRetryTransport(1).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if calls.Load() != 2 {
+		t.Errorf("idempotent mutation calls = %d, want 2", calls.Load())
+	}
+}
+
+func TestClient_RateLimitedError(t *testing.T) {
+	resetAt := time.Now().Add(10 * time.Minute).Truncate(time.Millisecond)
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("X-RateLimit-Requests-Remaining", "0")
+		w.Header().Set("X-RateLimit-Requests-Reset", strconv.FormatInt(resetAt.UnixMilli(), 10))
+		w.Header().Set("X-RateLimit-Complexity-Remaining", "1000")
+		w.Header().Set("X-RateLimit-Complexity-Reset", strconv.FormatInt(resetAt.Add(time.Hour).UnixMilli(), 10))
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Rate limit exceeded","extensions":{"code":"RATELIMITED"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{Token: "test-token", Endpoint: server.URL})
+	_, err := client.ListTeams(context.Background())
+
+	var rateErr *RateLimitedError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("ListTeams() error = %v, want RateLimitedError", err)
+	}
+	if !rateErr.ResetAt.Equal(resetAt) {
+		t.Errorf("ResetAt = %s, want %s", rateErr.ResetAt, resetAt)
+	}
+	// The reset is too far away to wait for, so the request is not retried
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestRetryTransport_WaitsForRateLimitReset(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+	resp, err := newTestRetryTransport(1).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Errorf("status = %d after %d calls, want 200 after 2", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryTransport_StopsWhenContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	transport := newTestRetryTransport(5)
+	transport.BaseDelay = time.Second
+	transport.MaxDelay = time.Second
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, nil)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("RoundTrip() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestRateLimitResetAt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Time
+	}{
+		{
+			name:   "no headers",
+			header: http.Header{},
+			want:   time.Time{},
+		},
+		{
+			name: "exhausted bucket",
+			header: http.Header{
+				"X-Ratelimit-Requests-Remaining": {"0"},
+				"X-Ratelimit-Requests-Reset":     {strconv.FormatInt(now.Add(time.Minute).UnixMilli(), 10)},
+			},
+			want: now.Add(time.Minute),
+		},
+		{
+			name: "bucket with remaining capacity is ignored",
+			header: http.Header{
+				"X-Ratelimit-Complexity-Remaining": {"50"},
+				"X-Ratelimit-Complexity-Reset":     {strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)},
+				"Retry-After":                      {"5"},
+			},
+			want: now.Add(5 * time.Second),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rateLimitResetAt(tt.header, now); !got.Equal(tt.want) {
+				t.Errorf("rateLimitResetAt() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+// Package oauth implements the OAuth2 authorization code flow with PKCE for
+// Linear, a localhost redirect listener, and a token source that refreshes
+// expired access tokens.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Linear OAuth defaults.
+const (
+	DefaultAuthorizeURL = "https://linear.app/oauth/authorize"
+	DefaultTokenURL     = "https://api.linear.app/oauth/token"
+	DefaultRedirectURL  = "http://localhost:8788/callback"
+	DefaultScopes       = "read,write"
+)
+
+// Config describes an OAuth application.
+type Config struct {
+	ClientID     string
+	ClientSecret string // optional with PKCE
+	AuthorizeURL string
+	TokenURL     string
+	RedirectURL  string // must match the redirect URI registered for the app
+	Scopes       string // comma-separated, as Linear expects
+}
+
+// Token is an OAuth token as stored on disk.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+}
+
+// expiryLeeway refreshes tokens slightly before they expire so a request does
+// not race the expiry.
+const expiryLeeway = time.Minute
+
+// Expired reports whether the access token has expired (or is about to) at now.
+// Tokens without an expiry never expire.
+func (t Token) Expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Add(expiryLeeway).Before(t.ExpiresAt)
+}
+
+// Header returns the Authorization header value for the token.
+func (t Token) Header() string {
+	tokenType := t.TokenType
+	if tokenType == "" || strings.EqualFold(tokenType, "bearer") {
+		tokenType = "Bearer"
+	}
+	return tokenType + " " + t.AccessToken
+}
+
+// tokenResponse is the token endpoint's JSON response.
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Login runs the authorization code flow with PKCE. It listens on the
+// redirect URL, calls open with the authorization URL (typically to launch a
+// browser), waits for the redirect, and exchanges the code for a token. The
+// flow is aborted when ctx is done.
+func Login(ctx context.Context, cfg Config, open func(authURL string) error) (Token, error) {
+	if cfg.ClientID == "" {
+		return Token{}, errors.New("oauth client ID is not set")
+	}
+	redirect, err := url.Parse(cfg.RedirectURL)
+	if err != nil || redirect.Host == "" {
+		return Token{}, fmt.Errorf("invalid oauth redirect URL %q", cfg.RedirectURL)
+	}
+
+	listener, err := net.Listen("tcp", redirect.Host)
+	if err != nil {
+		return Token{}, fmt.Errorf("listen for oauth redirect on %s: %w", redirect.Host, err)
+	}
+	defer listener.Close()
+	// Port 0 picks a free port; the redirect URI must then use the actual one
+	if redirect.Port() == "0" {
+		_, port, _ := net.SplitHostPort(listener.Addr().String())
+		redirect.Host = net.JoinHostPort(redirect.Hostname(), port)
+	}
+	redirectURI := redirect.String()
+
+	verifier, err := randomString()
+	if err != nil {
+		return Token{}, err
+	}
+	state, err := randomString()
+	if err != nil {
+		return Token{}, err
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+	mux := http.NewServeMux()
+	path := redirect.Path
+	if path == "" {
+		path = "/"
+	}
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var res result
+		switch {
+		case query.Get("state") != state:
+			res.err = errors.New("oauth redirect has an invalid state")
+		case query.Get("error") != "":
+			res.err = fmt.Errorf("authorization denied: %s", describeError(query.Get("error"), query.Get("error_description")))
+		case query.Get("code") == "":
+			res.err = errors.New("oauth redirect has no authorization code")
+		default:
+			res.code = query.Get("code")
+		}
+		if res.err != nil {
+			http.Error(w, "linear-tui login failed: "+res.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "linear-tui is authorized. You can close this window.")
+		}
+		select {
+		case results <- res:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.ErrorWithErr(err, "oauth.login: redirect listener stopped")
+		}
+	}()
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	authURL, err := authorizeURL(cfg, redirectURI, state, challenge(verifier))
+	if err != nil {
+		return Token{}, err
+	}
+	if open != nil {
+		if err := open(authURL); err != nil {
+			logger.Warning("oauth.login: failed to open authorization URL: %v", err)
+		}
+	}
+
+	var res result
+	select {
+	case res = <-results:
+	case <-ctx.Done():
+		return Token{}, fmt.Errorf("waiting for oauth redirect: %w", ctx.Err())
+	}
+	if res.err != nil {
+		return Token{}, res.err
+	}
+
+	logger.Debug("oauth.login: exchanging authorization code")
+	return requestToken(ctx, cfg, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {res.code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+	}, "")
+}
+
+// Refresh exchanges a refresh token for a new token. The old refresh token is
+// kept when the server does not issue a new one.
+func Refresh(ctx context.Context, cfg Config, refreshToken string) (Token, error) {
+	if refreshToken == "" {
+		return Token{}, errors.New("no refresh token: run `linear-tui login` again")
+	}
+	return requestToken(ctx, cfg, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}, refreshToken)
+}
+
+// authorizeURL builds the URL of the authorization page.
+func authorizeURL(cfg Config, redirectURI, state, codeChallenge string) (string, error) {
+	u, err := url.Parse(cfg.AuthorizeURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid oauth authorize URL %q: %w", cfg.AuthorizeURL, err)
+	}
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", cfg.ClientID)
+	query.Set("redirect_uri", redirectURI)
+	query.Set("scope", cfg.Scopes)
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// requestToken posts a token request and parses the response. previousRefresh
+// is kept as the refresh token when the response does not include one.
+func requestToken(ctx context.Context, cfg Config, form url.Values, previousRefresh string) (Token, error) {
+	form.Set("client_id", cfg.ClientID)
+	if cfg.ClientSecret != "" {
+		form.Set("client_secret", cfg.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, fmt.Errorf("create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Token{}, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return Token{}, fmt.Errorf("read token response: %w", err)
+	}
+	var parsed tokenResponse
+	if err := json.Unmarshal(body, &parsed); err != nil && resp.StatusCode == http.StatusOK {
+		return Token{}, fmt.Errorf("parse token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || parsed.Error != "" {
+		if parsed.Error != "" {
+			return Token{}, fmt.Errorf("token request failed: %s", describeError(parsed.Error, parsed.ErrorDescription))
+		}
+		return Token{}, fmt.Errorf("token request failed: %s", resp.Status)
+	}
+	if parsed.AccessToken == "" {
+		return Token{}, errors.New("token response has no access token")
+	}
+
+	token := Token{
+		AccessToken:  parsed.AccessToken,
+		TokenType:    parsed.TokenType,
+		RefreshToken: parsed.RefreshToken,
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = previousRefresh
+	}
+	if parsed.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(parsed.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// describeError formats an OAuth error code and optional description.
+func describeError(code, description string) string {
+	if description == "" {
+		return code
+	}
+	return code + ": " + description
+}
+
+// randomString returns a URL-safe random string for the PKCE verifier and state.
+func randomString() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// challenge returns the S256 PKCE code challenge for verifier.
+func challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,237 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeServer is a local OAuth server that issues rotating tokens.
+type fakeServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	challenge string
+	redirect  string
+	refresh   string
+	issued    int
+	denyLogin bool
+}
+
+// newFakeServer starts a fake OAuth server for client "client-1".
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+	f := &fakeServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", f.authorize)
+	mux.HandleFunc("/token", f.token)
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// config returns a client config pointing at the fake server.
+func (f *fakeServer) config() Config {
+	return Config{
+		ClientID:     "client-1",
+		AuthorizeURL: f.URL + "/authorize",
+		TokenURL:     f.URL + "/token",
+		RedirectURL:  "http://127.0.0.1:0/callback",
+		Scopes:       DefaultScopes,
+	}
+}
+
+func (f *fakeServer) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != "client-1" || query.Get("code_challenge_method") != "S256" || query.Get("response_type") != "code" {
+		http.Error(w, "bad authorize request", http.StatusBadRequest)
+		return
+	}
+	f.mu.Lock()
+	f.challenge = query.Get("code_challenge")
+	f.redirect = query.Get("redirect_uri")
+	deny := f.denyLogin
+	f.mu.Unlock()
+
+	target, _ := url.Parse(query.Get("redirect_uri"))
+	values := url.Values{"state": {query.Get("state")}}
+	if deny {
+		values.Set("error", "access_denied")
+	} else {
+		values.Set("code", "code-1")
+	}
+	target.RawQuery = values.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func (f *fakeServer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("client_id") != "client-1" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "code-1" || r.PostForm.Get("redirect_uri") != f.redirect ||
+			base64.RawURLEncoding.EncodeToString(sum[:]) != f.challenge {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+			return
+		}
+	case "refresh_token":
+		if r.PostForm.Get("refresh_token") != f.refresh {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "refresh token revoked"})
+			return
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	f.issued++
+	f.refresh = fmt.Sprintf("refresh-%d", f.issued)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  fmt.Sprintf("access-%d", f.issued),
+		"token_type":    "Bearer",
+		"refresh_token": f.refresh,
+		"expires_in":    3600,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// visit follows the authorization URL the way a browser would.
+func visit(authURL string) error {
+	resp, err := http.Get(authURL)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func TestLogin(t *testing.T) {
+	server := newFakeServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	token, err := Login(ctx, server.config(), visit)
+	if err != nil {
+		t.Fatalf("Login() error: %v", err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" {
+		t.Errorf("Login() token = %+v", token)
+	}
+	if token.Header() != "Bearer access-1" {
+		t.Errorf("Header() = %q, want Bearer access-1", token.Header())
+	}
+	if token.Expired(time.Now()) || !token.Expired(time.Now().Add(time.Hour)) {
+		t.Errorf("token expiry = %v, want about an hour from now", token.ExpiresAt)
+	}
+}
+
+func TestLoginErrors(t *testing.T) {
+	server := newFakeServer(t)
+	server.denyLogin = true
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := Login(ctx, server.config(), visit)
+	if err == nil || !strings.Contains(err.Error(), "access_denied") {
+		t.Errorf("Login() error = %v, want access_denied", err)
+	}
+
+	// A redirect that never arrives gives up with the context
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = Login(ctx, server.config(), nil)
+	if err == nil || !strings.Contains(err.Error(), "waiting for oauth redirect") {
+		t.Errorf("Login() error = %v, want timeout", err)
+	}
+
+	if _, err := Login(context.Background(), Config{}, nil); err == nil {
+		t.Error("Login() without client ID succeeded, want error")
+	}
+}
+
+func TestSourceRefreshesExpiredToken(t *testing.T) {
+	server := newFakeServer(t)
+	path := filepath.Join(t.TempDir(), "oauth_token.json")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	token, err := Login(ctx, server.config(), visit)
+	if err != nil {
+		t.Fatalf("Login() error: %v", err)
+	}
+	if err := SaveToken(path, token); err != nil {
+		t.Fatalf("SaveToken() error: %v", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("token file mode = %v (%v), want 0600", info.Mode().Perm(), err)
+	}
+
+	source, err := OpenSource(server.config(), path)
+	if err != nil {
+		t.Fatalf("OpenSource() error: %v", err)
+	}
+	if header, err := source.Token(ctx); err != nil || header != "Bearer access-1" {
+		t.Fatalf("Token() = %q, %v; want Bearer access-1", header, err)
+	}
+
+	// After expiry the token is refreshed and saved
+	source.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if header, err := source.Token(ctx); err != nil || header != "Bearer access-2" {
+		t.Fatalf("Token() after expiry = %q, %v; want Bearer access-2", header, err)
+	}
+	saved, err := LoadToken(path)
+	if err != nil || saved.AccessToken != "access-2" || saved.RefreshToken != "refresh-2" {
+		t.Errorf("saved token = %+v, %v; want access-2/refresh-2", saved, err)
+	}
+
+	// A 401 with a stale header reuses the token refreshed in the meantime
+	source.now = time.Now
+	if header, err := source.Refresh(ctx, "Bearer access-1"); err != nil || header != "Bearer access-2" {
+		t.Errorf("Refresh(stale) = %q, %v; want Bearer access-2", header, err)
+	}
+	if header, err := source.Refresh(ctx, "Bearer access-2"); err != nil || header != "Bearer access-3" {
+		t.Errorf("Refresh(current) = %q, %v; want Bearer access-3", header, err)
+	}
+}
+
+func TestSourceReportsRevokedRefreshToken(t *testing.T) {
+	server := newFakeServer(t)
+	path := filepath.Join(t.TempDir(), "oauth_token.json")
+	if err := SaveToken(path, Token{AccessToken: "old", RefreshToken: "revoked", ExpiresAt: time.Now().Add(-time.Hour)}); err != nil {
+		t.Fatalf("SaveToken() error: %v", err)
+	}
+
+	source, err := OpenSource(server.config(), path)
+	if err != nil {
+		t.Fatalf("OpenSource() error: %v", err)
+	}
+	_, err = source.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "refresh token revoked") {
+		t.Errorf("Token() error = %v, want revoked refresh token", err)
+	}
+
+	if _, err := OpenSource(server.config(), filepath.Join(t.TempDir(), "missing.json")); err != ErrNotLoggedIn {
+		t.Errorf("OpenSource() error = %v, want ErrNotLoggedIn", err)
+	}
+}
@@ -0,0 +1,140 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// ErrNotLoggedIn is returned when no token has been stored yet.
+var ErrNotLoggedIn = errors.New("not logged in: run `linear-tui login`")
+
+// LoadToken reads a stored token.
+func LoadToken(path string) (Token, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Token{}, ErrNotLoggedIn
+	}
+	if err != nil {
+		return Token{}, fmt.Errorf("read oauth token: %w", err)
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return Token{}, fmt.Errorf("parse oauth token %s: %w", path, err)
+	}
+	if token.AccessToken == "" {
+		return Token{}, ErrNotLoggedIn
+	}
+	return token, nil
+}
+
+// SaveToken writes a token to a file only its owner can read. The file is
+// replaced atomically so a crash never leaves a truncated token behind.
+func SaveToken(path string, token Token) error {
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal oauth token: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create token directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".oauth-token-*")
+	if err != nil {
+		return fmt.Errorf("create oauth token file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0600); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("chmod oauth token file: %w", err)
+	}
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write oauth token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write oauth token file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("replace oauth token file: %w", err)
+	}
+	return nil
+}
+
+// Source supplies Authorization headers from a stored token, refreshing the
+// token when it expires or the API rejects it and saving the refreshed token.
+// It is safe for concurrent use.
+type Source struct {
+	cfg  Config
+	path string
+	now  func() time.Time
+
+	mu    sync.Mutex
+	token Token
+}
+
+// OpenSource loads the token stored at path. It returns ErrNotLoggedIn when
+// there is none.
+func OpenSource(cfg Config, path string) (*Source, error) {
+	token, err := LoadToken(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Source{cfg: cfg, path: path, now: time.Now, token: token}, nil
+}
+
+// Token returns the Authorization header value, refreshing an expired token
+// first.
+func (s *Source) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Expired(s.now()) {
+		logger.Debug("oauth.source: access token expired, refreshing")
+		if err := s.refreshLocked(ctx); err != nil {
+			return "", err
+		}
+	}
+	return s.token.Header(), nil
+}
+
+// Refresh replaces a token the API rejected. stale is the rejected header
+// value; if the token was already refreshed by a concurrent request, the
+// current token is returned without refreshing again.
+func (s *Source) Refresh(ctx context.Context, stale string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Header() != stale {
+		return s.token.Header(), nil
+	}
+	if err := s.refreshLocked(ctx); err != nil {
+		return "", err
+	}
+	return s.token.Header(), nil
+}
+
+// refreshLocked refreshes and saves the token. s.mu must be held.
+func (s *Source) refreshLocked(ctx context.Context) error {
+	token, err := Refresh(ctx, s.cfg, s.token.RefreshToken)
+	if err != nil {
+		return fmt.Errorf("refresh oauth token: %w", err)
+	}
+	s.token = token
+	if err := SaveToken(s.path, token); err != nil {
+		// The new token still works for this session
+		logger.ErrorWithErr(err, "oauth.source: failed to save refreshed token path=%s", s.path)
+	}
+	logger.Info("oauth.source: refreshed access token")
+	return nil
+}
@@ -0,0 +1,123 @@
+package offline
+
+import (
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// Lookup resolves IDs in queued mutations to display names for optimistic updates.
+type Lookup struct {
+	States      []linearapi.WorkflowState
+	Users       []linearapi.User
+	Labels      []linearapi.IssueLabel
+	CurrentUser *linearapi.User
+}
+
+// ApplyUpdate returns issue with an update applied locally, as the server would
+// apply it. Names are resolved through lookup; unknown IDs keep the ID change
+// but leave the previous display name.
+func ApplyUpdate(issue linearapi.Issue, input linearapi.UpdateIssueInput, lookup Lookup) linearapi.Issue {
+	if input.Title != nil {
+		issue.Title = *input.Title
+	}
+	if input.Description != nil {
+		issue.Description = *input.Description
+	}
+	if input.StateID != nil {
+		issue.StateID = *input.StateID
+		for _, state := range lookup.States {
+			if state.ID == *input.StateID {
+				issue.State = state.Name
+				break
+			}
+		}
+	}
+	if input.AssigneeID != nil {
+		issue.AssigneeID = *input.AssigneeID
+		issue.Assignee = ""
+		for _, user := range lookup.Users {
+			if user.ID == *input.AssigneeID {
+				issue.Assignee = user.Name
+				break
+			}
+		}
+	}
+	if input.Priority != nil {
+		issue.Priority = *input.Priority
+	}
+	if input.LabelIDs != nil {
+		labels := make([]linearapi.IssueLabel, 0, len(*input.LabelIDs))
+		for _, id := range *input.LabelIDs {
+			label := linearapi.IssueLabel{ID: id}
+			for _, known := range lookup.Labels {
+				if known.ID == id {
+					label = known
+					break
+				}
+			}
+			labels = append(labels, label)
+		}
+		issue.Labels = labels
+	}
+	if input.ParentID != nil && *input.ParentID == "" {
+		issue.Parent = nil
+	}
+	if input.CycleID != nil && *input.CycleID == "" {
+		issue.Cycle = nil
+	}
+	return issue
+}
+
+// ApplyPending overlays queued mutations onto an issue list so that pending
+// changes stay visible when the list is re-rendered from cache.
+func ApplyPending(issues []linearapi.Issue, pending []Mutation, lookup Lookup) []linearapi.Issue {
+	if len(pending) == 0 {
+		return issues
+	}
+	byIssue := make(map[string][]Mutation, len(pending))
+	for _, m := range pending {
+		byIssue[m.IssueID] = append(byIssue[m.IssueID], m)
+	}
+
+	result := make([]linearapi.Issue, len(issues))
+	for i, issue := range issues {
+		for _, m := range byIssue[issue.ID] {
+			issue = applyMutation(issue, m, lookup)
+		}
+		result[i] = issue
+	}
+	return result
+}
+
+// applyMutation applies a single queued mutation to an issue.
+func applyMutation(issue linearapi.Issue, m Mutation, lookup Lookup) linearapi.Issue {
+	switch m.Kind {
+	case MutationUpdateIssue:
+		if m.Update != nil {
+			return ApplyUpdate(issue, *m.Update, lookup)
+		}
+	case MutationCreateComment:
+		if m.Comment != nil {
+			id := "pending-" + m.ID
+			for _, existing := range issue.Comments {
+				if existing.ID == id {
+					return issue
+				}
+			}
+			comment := linearapi.Comment{
+				ID:        id,
+				Body:      m.Comment.Body,
+				CreatedAt: m.QueuedAt,
+				UpdatedAt: m.QueuedAt,
+				IssueID:   issue.ID,
+				ParentID:  m.Comment.ParentID,
+			}
+			if lookup.CurrentUser != nil {
+				comment.Author = *lookup.CurrentUser
+			}
+			comments := make([]linearapi.Comment, 0, len(issue.Comments)+1)
+			comments = append(comments, issue.Comments...)
+			issue.Comments = append(comments, comment)
+		}
+	}
+	return issue
+}
@@ -0,0 +1,218 @@
+// Package offline queues issue mutations made while the Linear API is
+// unreachable and replays them in order once connectivity returns.
+package offline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// queueFileVersion is bumped whenever the on-disk format changes incompatibly.
+const queueFileVersion = 1
+
+// MutationKind identifies the API call a queued mutation replays.
+type MutationKind string
+
+// Supported mutation kinds.
+const (
+	MutationUpdateIssue   MutationKind = "update_issue"
+	MutationCreateComment MutationKind = "create_comment"
+)
+
+// Mutation is a single queued API call.
+type Mutation struct {
+	ID              string                        `json:"id"`
+	Kind            MutationKind                  `json:"kind"`
+	IssueID         string                        `json:"issue_id"`
+	IssueIdentifier string                        `json:"issue_identifier"`
+	Update          *linearapi.UpdateIssueInput   `json:"update,omitempty"`
+	Comment         *linearapi.CreateCommentInput `json:"comment,omitempty"`
+	// BaseUpdatedAt is the issue's updatedAt when the change was made locally.
+	// A newer server-side updatedAt at replay time is reported as a conflict.
+	BaseUpdatedAt time.Time `json:"base_updated_at"`
+	QueuedAt      time.Time `json:"queued_at"`
+}
+
+// Describe returns a short human-readable summary of the mutation.
+func (m Mutation) Describe() string {
+	switch m.Kind {
+	case MutationCreateComment:
+		return fmt.Sprintf("comment on %s", m.IssueIdentifier)
+	case MutationUpdateIssue:
+		return fmt.Sprintf("update to %s", m.IssueIdentifier)
+	default:
+		return fmt.Sprintf("%s on %s", m.Kind, m.IssueIdentifier)
+	}
+}
+
+// queueFile is the JSON layout of the queue file.
+type queueFile struct {
+	Version   int        `json:"version"`
+	Owner     string     `json:"owner"`
+	Mutations []Mutation `json:"mutations"`
+}
+
+// Queue is an ordered, disk-backed list of pending mutations.
+type Queue struct {
+	path  string
+	owner string
+
+	mu        sync.Mutex
+	mutations []Mutation
+	seq       atomic.Int64
+
+	// saveMu orders writes so an older save never replaces a newer file
+	saveMu sync.Mutex
+}
+
+// OpenQueue loads the queue file at path. owner identifies the workspace
+// credentials the queue belongs to; mutations queued for another owner are not loaded.
+func OpenQueue(path, owner string) (*Queue, error) {
+	if path == "" {
+		return nil, fmt.Errorf("queue path is empty")
+	}
+
+	q := &Queue{path: path, owner: owner}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return q, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read queue file: %w", err)
+	}
+
+	var file queueFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse queue file: %w", err)
+	}
+	if file.Version != queueFileVersion || file.Owner != owner {
+		logger.Warning("offline.queue: ignoring queue file for another version or workspace path=%s mutations=%d", path, len(file.Mutations))
+		return q, nil
+	}
+	q.mutations = file.Mutations
+
+	logger.Debug("offline.queue: loaded queue path=%s pending=%d", path, len(q.mutations))
+	return q, nil
+}
+
+// Enqueue appends a mutation and persists the queue.
+func (q *Queue) Enqueue(m Mutation) error {
+	q.mu.Lock()
+	if m.ID == "" {
+		m.ID = fmt.Sprintf("%d-%d", time.Now().UnixNano(), q.seq.Add(1))
+	}
+	if m.QueuedAt.IsZero() {
+		m.QueuedAt = time.Now()
+	}
+	q.mutations = append(q.mutations, m)
+	q.mu.Unlock()
+
+	logger.Info("offline.queue: queued mutation kind=%s issue=%s", m.Kind, m.IssueIdentifier)
+	return q.Save()
+}
+
+// Pending returns a copy of the queued mutations in replay order.
+func (q *Queue) Pending() []Mutation {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	pending := make([]Mutation, len(q.mutations))
+	copy(pending, q.mutations)
+	return pending
+}
+
+// next returns the oldest queued mutation.
+func (q *Queue) next() (Mutation, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.mutations) == 0 {
+		return Mutation{}, false
+	}
+	return q.mutations[0], true
+}
+
+// Len returns the number of queued mutations.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.mutations)
+}
+
+// Remove drops a mutation by ID and persists the queue.
+func (q *Queue) Remove(id string) error {
+	q.mu.Lock()
+	for i, m := range q.mutations {
+		if m.ID == id {
+			q.mutations = append(q.mutations[:i], q.mutations[i+1:]...)
+			break
+		}
+	}
+	q.mu.Unlock()
+	return q.Save()
+}
+
+// rebase updates the conflict base of queued mutations for an issue after one
+// of our own mutations moved its server-side updatedAt.
+func (q *Queue) rebase(issueID string, updatedAt time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i := range q.mutations {
+		if q.mutations[i].IssueID == issueID {
+			q.mutations[i].BaseUpdatedAt = updatedAt
+		}
+	}
+}
+
+// Save writes the queue to disk. The file is replaced atomically so a crash
+// mid-write never leaves a truncated queue behind. Concurrent saves are
+// serialized, so the file always ends up with the latest mutations.
+func (q *Queue) Save() error {
+	q.saveMu.Lock()
+	defer q.saveMu.Unlock()
+
+	q.mu.Lock()
+	data, err := json.MarshalIndent(queueFile{
+		Version:   queueFileVersion,
+		Owner:     q.owner,
+		Mutations: q.mutations,
+	}, "", "  ")
+	q.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("marshal queue: %w", err)
+	}
+	data = append(data, '\n')
+
+	dir := filepath.Dir(q.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create queue directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".offline_queue-*.json")
+	if err != nil {
+		return fmt.Errorf("create temp queue file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("write queue file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("write queue file: %w", err)
+	}
+	if err := os.Rename(tmpPath, q.path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("replace queue file: %w", err)
+	}
+	return nil
+}
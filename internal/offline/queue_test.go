@@ -0,0 +1,204 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestQueue_EnqueuePersistsAcrossOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offline_queue.json")
+	q, err := OpenQueue(path, "owner")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+
+	title := "New title"
+	if err := q.Enqueue(Mutation{
+		Kind:            MutationUpdateIssue,
+		IssueID:         "issue-1",
+		IssueIdentifier: "ENG-1",
+		Update:          &linearapi.UpdateIssueInput{ID: "issue-1", Title: &title},
+	}); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if err := q.Enqueue(Mutation{
+		Kind:            MutationCreateComment,
+		IssueID:         "issue-1",
+		IssueIdentifier: "ENG-1",
+		Comment:         &linearapi.CreateCommentInput{IssueID: "issue-1", Body: "hi"},
+	}); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	reopened, err := OpenQueue(path, "owner")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+	pending := reopened.Pending()
+	if len(pending) != 2 {
+		t.Fatalf("len(Pending()) = %d, want 2", len(pending))
+	}
+	if pending[0].Kind != MutationUpdateIssue || pending[0].Update == nil || *pending[0].Update.Title != "New title" {
+		t.Errorf("pending[0] = %+v, want title update", pending[0])
+	}
+	if pending[1].Kind != MutationCreateComment || pending[1].Comment.Body != "hi" {
+		t.Errorf("pending[1] = %+v, want comment", pending[1])
+	}
+	if pending[0].ID == "" || pending[0].ID == pending[1].ID || pending[0].QueuedAt.IsZero() {
+		t.Errorf("expected unique IDs and queue times, got %+v", pending)
+	}
+
+	if err := reopened.Remove(pending[0].ID); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if reopened.Len() != 1 {
+		t.Errorf("Len() = %d, want 1 after Remove", reopened.Len())
+	}
+}
+
+func TestQueue_ConcurrentEnqueueAndRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offline_queue.json")
+	q, err := OpenQueue(path, "owner")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+
+	const count = 20
+	for i := 0; i < count; i++ {
+		if err := q.Enqueue(Mutation{ID: fmt.Sprintf("old-%d", i), Kind: MutationCreateComment}); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+
+	// Replay removes mutations while the UI queues new ones
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := q.Remove(fmt.Sprintf("old-%d", i)); err != nil {
+				t.Errorf("Remove() error = %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := q.Enqueue(Mutation{ID: fmt.Sprintf("new-%d", i), Kind: MutationCreateComment}); err != nil {
+				t.Errorf("Enqueue() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	reopened, err := OpenQueue(path, "owner")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+	pending := reopened.Pending()
+	if len(pending) != count {
+		t.Fatalf("len(Pending()) = %d, want %d", len(pending), count)
+	}
+	for _, m := range pending {
+		if !strings.HasPrefix(m.ID, "new-") {
+			t.Errorf("pending mutation %s was already removed", m.ID)
+		}
+	}
+}
+
+func TestOpenQueue_IgnoresOtherOwner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offline_queue.json")
+	q, err := OpenQueue(path, "owner-1")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+	if err := q.Enqueue(Mutation{Kind: MutationCreateComment, IssueID: "issue-1"}); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	other, err := OpenQueue(path, "owner-2")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+	if other.Len() != 0 {
+		t.Errorf("Len() = %d, want 0 for another owner", other.Len())
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "graphql error", err: errors.New("Entity not found"), want: false},
+		{name: "dial error", err: dialErr, want: true},
+		{name: "wrapped url error", err: fmt.Errorf("update issue: %w", &url.Error{Op: "Post", URL: "https://api.linear.app", Err: dialErr}), want: true},
+		{name: "dns error", err: &net.DNSError{Err: "no such host", Name: "api.linear.app"}, want: true},
+		{name: "deadline", err: fmt.Errorf("fetch: %w", context.DeadlineExceeded), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsNetworkError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyPending(t *testing.T) {
+	stateID := "state-done"
+	assigneeID := "user-2"
+	labelIDs := []string{"lbl-1"}
+	issues := []linearapi.Issue{
+		{ID: "issue-1", State: "Todo", StateID: "state-todo", Assignee: "Jane", AssigneeID: "user-1"},
+		{ID: "issue-2", State: "Todo"},
+	}
+	pending := []Mutation{
+		{Kind: MutationUpdateIssue, IssueID: "issue-1", Update: &linearapi.UpdateIssueInput{ID: "issue-1", StateID: &stateID}},
+		{Kind: MutationUpdateIssue, IssueID: "issue-1", Update: &linearapi.UpdateIssueInput{ID: "issue-1", AssigneeID: &assigneeID, LabelIDs: &labelIDs}},
+		{ID: "m3", Kind: MutationCreateComment, IssueID: "issue-1", Comment: &linearapi.CreateCommentInput{IssueID: "issue-1", Body: "queued"}, QueuedAt: time.Now()},
+	}
+	lookup := Lookup{
+		States:      []linearapi.WorkflowState{{ID: "state-done", Name: "Done"}},
+		Users:       []linearapi.User{{ID: "user-2", Name: "Sam"}},
+		Labels:      []linearapi.IssueLabel{{ID: "lbl-1", Name: "Bug"}},
+		CurrentUser: &linearapi.User{ID: "me", Name: "Me"},
+	}
+
+	result := ApplyPending(issues, pending, lookup)
+
+	got := result[0]
+	if got.State != "Done" || got.StateID != "state-done" {
+		t.Errorf("state = %q/%q, want Done/state-done", got.State, got.StateID)
+	}
+	if got.Assignee != "Sam" || got.AssigneeID != "user-2" {
+		t.Errorf("assignee = %q/%q, want Sam/user-2", got.Assignee, got.AssigneeID)
+	}
+	if len(got.Labels) != 1 || got.Labels[0].Name != "Bug" {
+		t.Errorf("labels = %+v, want Bug", got.Labels)
+	}
+	if len(got.Comments) != 1 || got.Comments[0].Body != "queued" || got.Comments[0].Author.Name != "Me" {
+		t.Errorf("comments = %+v, want pending comment by Me", got.Comments)
+	}
+	if again := ApplyPending(result, pending, lookup); len(again[0].Comments) != 1 {
+		t.Errorf("re-applying pending mutations duplicated comments: %+v", again[0].Comments)
+	}
+	if result[1].State != "Todo" {
+		t.Errorf("unrelated issue changed: %+v", result[1])
+	}
+	if issues[0].State != "Todo" {
+		t.Error("ApplyPending must not modify the input slice")
+	}
+}
@@ -0,0 +1,115 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// ReplayAPI is the subset of linearapi.Client used to replay mutations.
+type ReplayAPI interface {
+	FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error)
+	UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error)
+	CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error)
+}
+
+// Conflict describes a queued update that was discarded because the issue
+// changed on the server after the update was made offline.
+type Conflict struct {
+	Mutation        Mutation
+	ServerUpdatedAt time.Time
+}
+
+// Failure describes a queued mutation the API rejected.
+type Failure struct {
+	Mutation Mutation
+	Err      error
+}
+
+// ReplayResult summarizes a replay run.
+type ReplayResult struct {
+	Applied   int
+	Conflicts []Conflict
+	Failures  []Failure
+	// Remaining is the number of mutations still queued (non-zero when the
+	// replay stopped because the API became unreachable again).
+	Remaining int
+}
+
+// Replay applies queued mutations in order. Updates whose issue changed on the
+// server since they were queued are dropped and reported as conflicts, so the
+// server-side change wins. Mutations the API rejects are dropped and reported
+// as failures. Replay stops at the first network error and returns it, leaving
+// that mutation and everything after it queued.
+func Replay(ctx context.Context, api ReplayAPI, q *Queue) (ReplayResult, error) {
+	var result ReplayResult
+
+	for {
+		// Re-read the head each time: replaying an update rebases later
+		// mutations for the same issue.
+		m, ok := q.next()
+		if !ok {
+			break
+		}
+		err := replayMutation(ctx, api, q, m, &result)
+		if IsNetworkError(err) {
+			result.Remaining = q.Len()
+			logger.Warning("offline.replay: stopped, API unreachable applied=%d remaining=%d", result.Applied, result.Remaining)
+			return result, err
+		}
+		if err != nil {
+			logger.ErrorWithErr(err, "offline.replay: mutation rejected kind=%s issue=%s", m.Kind, m.IssueIdentifier)
+			result.Failures = append(result.Failures, Failure{Mutation: m, Err: err})
+		}
+		if removeErr := q.Remove(m.ID); removeErr != nil {
+			return result, fmt.Errorf("update queue: %w", removeErr)
+		}
+	}
+
+	result.Remaining = q.Len()
+	logger.Info("offline.replay: finished applied=%d conflicts=%d failures=%d", result.Applied, len(result.Conflicts), len(result.Failures))
+	return result, nil
+}
+
+// replayMutation applies one mutation, recording conflicts and successes in result.
+// A returned error means the mutation was not applied.
+func replayMutation(ctx context.Context, api ReplayAPI, q *Queue, m Mutation, result *ReplayResult) error {
+	switch m.Kind {
+	case MutationUpdateIssue:
+		if m.Update == nil {
+			return fmt.Errorf("queued update for %s has no input", m.IssueIdentifier)
+		}
+		current, err := api.FetchIssueByID(ctx, m.IssueID)
+		if err != nil {
+			return err
+		}
+		if !m.BaseUpdatedAt.IsZero() && current.UpdatedAt.After(m.BaseUpdatedAt) {
+			logger.Warning("offline.replay: conflict issue=%s base_updated_at=%s server_updated_at=%s",
+				m.IssueIdentifier, m.BaseUpdatedAt.Format(time.RFC3339), current.UpdatedAt.Format(time.RFC3339))
+			result.Conflicts = append(result.Conflicts, Conflict{Mutation: m, ServerUpdatedAt: current.UpdatedAt})
+			return nil
+		}
+		updated, err := api.UpdateIssue(ctx, *m.Update)
+		if err != nil {
+			return err
+		}
+		// Later queued changes to the same issue were made on top of this one
+		q.rebase(m.IssueID, updated.UpdatedAt)
+	case MutationCreateComment:
+		if m.Comment == nil {
+			return fmt.Errorf("queued comment for %s has no input", m.IssueIdentifier)
+		}
+		if _, err := api.CreateComment(ctx, *m.Comment); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown mutation kind %q", m.Kind)
+	}
+
+	result.Applied++
+	logger.Debug("offline.replay: applied mutation kind=%s issue=%s", m.Kind, m.IssueIdentifier)
+	return nil
+}
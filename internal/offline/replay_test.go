@@ -0,0 +1,148 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// fakeReplayAPI records replayed calls and simulates server state.
+type fakeReplayAPI struct {
+	updatedAt map[string]time.Time
+	failOn    map[string]error // keyed by issue ID
+	updates   []linearapi.UpdateIssueInput
+	comments  []linearapi.CreateCommentInput
+}
+
+func (f *fakeReplayAPI) FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error) {
+	if err := f.failOn[id]; err != nil {
+		return linearapi.Issue{}, err
+	}
+	return linearapi.Issue{ID: id, UpdatedAt: f.updatedAt[id]}, nil
+}
+
+func (f *fakeReplayAPI) UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+	f.updates = append(f.updates, input)
+	f.updatedAt[input.ID] = f.updatedAt[input.ID].Add(time.Minute)
+	return linearapi.Issue{ID: input.ID, UpdatedAt: f.updatedAt[input.ID]}, nil
+}
+
+func (f *fakeReplayAPI) CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error) {
+	if err := f.failOn[input.IssueID]; err != nil {
+		return linearapi.Comment{}, err
+	}
+	f.comments = append(f.comments, input)
+	return linearapi.Comment{Body: input.Body}, nil
+}
+
+func newTestQueue(t *testing.T, mutations ...Mutation) *Queue {
+	t.Helper()
+	q, err := OpenQueue(filepath.Join(t.TempDir(), "offline_queue.json"), "owner")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+	for _, m := range mutations {
+		if err := q.Enqueue(m); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+	return q
+}
+
+func updateMutation(issueID string, base time.Time, title string) Mutation {
+	return Mutation{
+		Kind:            MutationUpdateIssue,
+		IssueID:         issueID,
+		IssueIdentifier: issueID,
+		Update:          &linearapi.UpdateIssueInput{ID: issueID, Title: &title},
+		BaseUpdatedAt:   base,
+	}
+}
+
+func TestReplay_AppliesInOrderAndRebases(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	api := &fakeReplayAPI{updatedAt: map[string]time.Time{"issue-1": base}}
+	// Both updates were made offline on top of the same server version
+	q := newTestQueue(t,
+		updateMutation("issue-1", base, "first"),
+		updateMutation("issue-1", base, "second"),
+		Mutation{Kind: MutationCreateComment, IssueID: "issue-1", Comment: &linearapi.CreateCommentInput{IssueID: "issue-1", Body: "done"}},
+	)
+
+	result, err := Replay(context.Background(), api, q)
+	if err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	if result.Applied != 3 || len(result.Conflicts) != 0 || result.Remaining != 0 {
+		t.Fatalf("Replay() = %+v, want 3 applied without conflicts", result)
+	}
+	if len(api.updates) != 2 || *api.updates[0].Title != "first" || *api.updates[1].Title != "second" {
+		t.Errorf("updates = %+v, want first then second", api.updates)
+	}
+	if len(api.comments) != 1 {
+		t.Errorf("comments = %+v, want 1", api.comments)
+	}
+}
+
+func TestReplay_ReportsConflicts(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	api := &fakeReplayAPI{updatedAt: map[string]time.Time{"issue-1": base.Add(time.Hour)}}
+	q := newTestQueue(t, updateMutation("issue-1", base, "stale"))
+
+	result, err := Replay(context.Background(), api, q)
+	if err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	if len(result.Conflicts) != 1 || !result.Conflicts[0].ServerUpdatedAt.Equal(base.Add(time.Hour)) {
+		t.Fatalf("Conflicts = %+v, want one conflict", result.Conflicts)
+	}
+	if len(api.updates) != 0 {
+		t.Errorf("conflicting update should not be sent, got %+v", api.updates)
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want conflict removed from queue", q.Len())
+	}
+}
+
+func TestReplay_StopsOnNetworkError(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	netErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	api := &fakeReplayAPI{
+		updatedAt: map[string]time.Time{"issue-1": base, "issue-2": base},
+		failOn:    map[string]error{"issue-2": netErr},
+	}
+	q := newTestQueue(t,
+		updateMutation("issue-1", base, "sent"),
+		updateMutation("issue-2", base, "blocked"),
+		updateMutation("issue-1", base, "after"),
+	)
+
+	result, err := Replay(context.Background(), api, q)
+	if !IsNetworkError(err) {
+		t.Fatalf("Replay() error = %v, want network error", err)
+	}
+	if result.Applied != 1 || result.Remaining != 2 || q.Len() != 2 {
+		t.Errorf("Replay() = %+v, queue len %d; want 1 applied, 2 remaining", result, q.Len())
+	}
+}
+
+func TestReplay_DropsRejectedMutations(t *testing.T) {
+	api := &fakeReplayAPI{
+		updatedAt: map[string]time.Time{},
+		failOn:    map[string]error{"issue-1": errors.New("Entity not found")},
+	}
+	q := newTestQueue(t, Mutation{Kind: MutationCreateComment, IssueID: "issue-1", Comment: &linearapi.CreateCommentInput{IssueID: "issue-1", Body: "x"}})
+
+	result, err := Replay(context.Background(), api, q)
+	if err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	if len(result.Failures) != 1 || q.Len() != 0 {
+		t.Errorf("Replay() = %+v, queue len %d; want rejected mutation dropped", result, q.Len())
+	}
+}
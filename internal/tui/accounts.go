@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"context"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/cache"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/offline"
+)
+
+// OpenStores opens the on-disk issue cache and offline queue of the active
+// account. Each profile keeps its own files, so switching accounts does not
+// discard the other account's cache. It should be called before Run.
+func (a *App) OpenStores() {
+	a.openStores(a.config)
+}
+
+// accountServices are the API client, caches, and stores of the active
+// account. Background work takes one snapshot of them so that an account
+// switch never mixes two accounts' services within one operation.
+type accountServices struct {
+	api             *linearapi.Client
+	cache           *cache.TeamCache
+	issueStore      *cache.DiskStore
+	offlineQueue    *offline.Queue
+	fetchIssuesPage issuePageFetcher
+	fetchIssueByID  func(context.Context, string) (linearapi.Issue, error)
+}
+
+// services returns the services of the active account. It is safe to call
+// from any goroutine.
+func (a *App) services() accountServices {
+	a.servicesMu.RLock()
+	defer a.servicesMu.RUnlock()
+	s := accountServices{
+		api:             a.api,
+		cache:           a.cache,
+		issueStore:      a.issueStore,
+		offlineQueue:    a.offlineQueue,
+		fetchIssuesPage: a.fetchIssuesPage,
+		fetchIssueByID:  a.fetchIssueByID,
+	}
+	if s.fetchIssuesPage == nil {
+		s.fetchIssuesPage = s.api.FetchIssuesPage
+	}
+	if s.fetchIssueByID == nil {
+		s.fetchIssueByID = s.api.FetchIssueByID
+	}
+	return s
+}
+
+// openStores replaces the issue store and offline queue with those of cfg's
+// account. A store that fails to open is disabled and the error is logged.
+func (a *App) openStores(cfg config.Config) {
+	owner := cache.OwnerKey(cfg.Endpoint(), cfg.AccountKey())
+
+	// On-disk issue cache: render the last known issues instantly, then delta sync
+	var store *cache.DiskStore
+	if cachePath, err := config.CacheFilePath(); err != nil {
+		logger.Warning("tui.app: failed to resolve cache file path: %v", err)
+	} else {
+		cachePath = config.ProfileFilePath(cachePath, cfg.Profile)
+		if store, err = cache.OpenDiskStore(cachePath, owner); err != nil {
+			logger.Warning("tui.app: failed to open cache file path=%s error=%v", cachePath, err)
+			store = nil
+		}
+	}
+
+	// Offline mode: queue updates and comments while the API is unreachable
+	var queue *offline.Queue
+	if queuePath, err := config.OfflineQueueFilePath(); err != nil {
+		logger.Warning("tui.app: failed to resolve offline queue path: %v", err)
+	} else {
+		queuePath = config.ProfileFilePath(queuePath, cfg.Profile)
+		if queue, err = offline.OpenQueue(queuePath, owner); err != nil {
+			logger.Warning("tui.app: failed to open offline queue path=%s error=%v", queuePath, err)
+			queue = nil
+		}
+	}
+
+	// Swap both at once so background work never pairs one account's store
+	// with the other's queue
+	a.servicesMu.Lock()
+	a.issueStore = store
+	a.offlineQueue = queue
+	teamCache := a.cache
+	a.servicesMu.Unlock()
+	if store != nil {
+		teamCache.Restore(store.Metadata())
+	}
+}
+
+// showAccountPicker shows the configured account profiles and switches to the
+// selected one.
+func (a *App) showAccountPicker() {
+	if len(a.config.Profiles) == 0 {
+		a.statusBar.SetText(a.themeTags.SecondaryText + "No account profiles configured (add \"profiles\" to config.json)[-]")
+		return
+	}
+
+	items := make([]PickerItem, 0, len(a.config.Profiles))
+	for _, profile := range a.config.Profiles {
+		label := profile.Name
+		if profile.Name == a.config.Profile {
+			label += " (current)"
+		}
+		items = append(items, PickerItem{
+			ID:    profile.Name,
+			Label: label,
+		})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Switch Account", items, func(item PickerItem) {
+		a.pickerActive = false
+		a.switchAccount(item.ID)
+	})
+}
+
+// switchAccount reloads the settings file and rebuilds the API client, team
+// cache, and navigation tree for the named profile.
+func (a *App) switchAccount(name string) {
+	if name == a.config.Profile {
+		return
+	}
+
+	logger.Info("tui.app: switching account profile=%s", name)
+	a.statusBar.SetText(a.themeTags.Warning + "Switching to " + name + "...[-]")
+	go func() {
+		cfg, err := loadProfileConfig(name)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.app: failed to switch account profile=%s", name)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			a.notifications = nil
+			a.openStores(cfg)
+			a.applySettings(cfg)
+		})
+	}()
+}
+
+// loadProfileConfig builds the configuration of a profile from the settings file.
+func loadProfileConfig(name string) (config.Config, error) {
+	settingsPath, err := config.ConfigFilePath()
+	if err != nil {
+		return config.Config{}, err
+	}
+	settings, err := config.LoadSettings(settingsPath)
+	if err != nil {
+		return config.Config{}, err
+	}
+	return config.ConfigForProfile(settings, name)
+}
+
+// navigationRootLabel returns the navigation tree root label, naming the
+// active account profile when there is one.
+func navigationRootLabel(profile string) string {
+	if profile == "" {
+		return "Linear"
+	}
+	return "Linear · " + profile
+}
+
+// findTeam returns the team matching ref by ID, or by key or name ignoring case.
+func findTeam(teams []linearapi.Team, ref string) (linearapi.Team, bool) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return linearapi.Team{}, false
+	}
+	for _, team := range teams {
+		if team.ID == ref || strings.EqualFold(team.Key, ref) || strings.EqualFold(team.Name, ref) {
+			return team, true
+		}
+	}
+	return linearapi.Team{}, false
+}
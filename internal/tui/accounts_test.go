@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/cache"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/offline"
+)
+
+func TestFindTeam(t *testing.T) {
+	teams := []linearapi.Team{
+		{ID: "team-eng", Key: "ENG", Name: "Engineering"},
+		{ID: "team-des", Key: "DES", Name: "Design"},
+	}
+
+	tests := []struct {
+		ref    string
+		wantID string
+	}{
+		{ref: "team-des", wantID: "team-des"},
+		{ref: "eng", wantID: "team-eng"},
+		{ref: " design ", wantID: "team-des"},
+		{ref: "OPS", wantID: ""},
+		{ref: "", wantID: ""},
+	}
+
+	for _, tt := range tests {
+		team, ok := findTeam(teams, tt.ref)
+		if ok != (tt.wantID != "") || team.ID != tt.wantID {
+			t.Errorf("findTeam(%q) = %q, %v; want %q", tt.ref, team.ID, ok, tt.wantID)
+		}
+	}
+}
+
+func TestNavigationRootLabel(t *testing.T) {
+	if got := navigationRootLabel(""); got != "Linear" {
+		t.Errorf("navigationRootLabel(\"\") = %q, want Linear", got)
+	}
+	if got := navigationRootLabel("work"); got != "Linear · work" {
+		t.Errorf("navigationRootLabel(work) = %q, want \"Linear · work\"", got)
+	}
+}
+
+// TestSwitchAccount_WhileBackgroundWorkRuns swaps the account services the way
+// switchAccount does while a refresh and offline replays are running. Run
+// with -race to catch unguarded reads of the replaced services.
+func TestSwitchAccount_WhileBackgroundWorkRuns(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	app, q := newOfflineTestApp(t)
+	ui := serializeUpdates(app)
+
+	store, err := cache.OpenDiskStore(filepath.Join(t.TempDir(), "cache.json"), "owner")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	app.SetIssueStore(store)
+	app.selectedNavigation = &NavigationNode{ID: "team-1", TeamID: "team-1", IsTeam: true}
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		// Failing keeps the refresh from writing snapshots after the test ends
+		return linearapi.IssuePage{}, errors.New("offline")
+	}
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		return linearapi.Issue{ID: id, Identifier: "ENG-1", TeamID: "team-1"}, nil
+	}
+	if err := q.Enqueue(offline.Mutation{
+		Kind:    offline.MutationCreateComment,
+		IssueID: "issue-1",
+		Comment: &linearapi.CreateCommentInput{IssueID: "issue-1", Body: "queued"},
+	}); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	app.refreshIssues()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			app.replayOfflineQueue()
+		}()
+		go func() {
+			defer wg.Done()
+			app.pendingMutationCount()
+			app.withPendingMutations([]linearapi.Issue{{ID: "issue-1"}})
+		}()
+	}
+
+	cfg := config.Config{Profile: "work", APIEndpoint: unreachableEndpoint(), CacheTTL: time.Minute, Timeout: time.Second}
+	app.QueueUpdateDraw(func() {
+		app.openStores(cfg)
+		app.rebuildAPI(cfg)
+	})
+
+	wg.Wait()
+	waitForRefresh(t, app, ui)
+
+	services := app.services()
+	if services.issueStore == store || services.offlineQueue == q {
+		t.Fatal("stores were not replaced with the work profile's")
+	}
+	if services.offlineQueue == nil || services.offlineQueue.Len() != 0 {
+		t.Errorf("work profile queue = %v, want an empty queue", services.offlineQueue)
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
 )
 
 // AgentOutputModal displays streaming output from an agent run.
@@ -32,6 +34,14 @@ type AgentOutputModal struct {
 	structured    bool
 	resumeCommand string
 
+	// Run details used to post the result to the issue
+	issue      linearapi.Issue
+	provider   string
+	model      string
+	durationMs int64
+	finalText  string
+	transcript []StreamLine
+
 	streamMu    sync.Mutex
 	pending     []StreamLine
 	flushTicker *time.Ticker
@@ -40,6 +50,16 @@ type AgentOutputModal struct {
 
 const maxFlushLines = 200
 
+// maxAgentTranscriptLabel is the line length shown when picking a transcript range.
+const maxAgentTranscriptLabel = 40
+
+// Options for posting agent output to the issue.
+const (
+	agentPostFinal      = "final"
+	agentPostRange      = "range"
+	agentPostTranscript = "transcript"
+)
+
 // NewAgentOutputModal creates a new agent output modal.
 func NewAgentOutputModal(app *App) *AgentOutputModal {
 	om := &AgentOutputModal{
@@ -86,7 +106,7 @@ func NewAgentOutputModal(app *App) *AgentOutputModal {
 		SetTitleColor(app.theme.Foreground)
 
 	om.helpView = tview.NewTextView()
-	om.helpView.SetText("Esc: cancel • c: copy • r: resume cmd • ↑↓/j/k: scroll")
+	om.helpView.SetText("Esc: cancel • c: copy • r: resume cmd • p: post to issue • ↑↓/j/k: scroll")
 	om.helpView.SetTextColor(app.theme.SecondaryText)
 	om.helpView.SetBackgroundColor(app.theme.HeaderBg)
 	om.helpView.SetTextAlign(tview.AlignCenter)
@@ -193,6 +213,12 @@ func (om *AgentOutputModal) Show(title string, onCancel func()) {
 	om.streamMu.Lock()
 	om.statusText = "Status: Running"
 	om.structured = false
+	om.issue = linearapi.Issue{}
+	om.provider = ""
+	om.model = ""
+	om.durationMs = 0
+	om.finalText = ""
+	om.transcript = nil
 	om.streamMu.Unlock()
 
 	om.app.pages.AddPage("agent_output", om.modal, true, true)
@@ -200,6 +226,45 @@ func (om *AgentOutputModal) Show(title string, onCancel func()) {
 	om.app.app.SetFocus(om.streamView)
 }
 
+// ShowTranscript displays the recorded output of a past agent run.
+func (om *AgentOutputModal) ShowTranscript(record agents.RunRecord) {
+	name := record.ProviderName
+	if name == "" {
+		name = record.Provider
+	}
+	om.Show(fmt.Sprintf(" %s Run %s ", name, record.ID), nil)
+	om.SetRun(linearapi.Issue{ID: record.IssueID, Identifier: record.IssueIdentifier}, name, record.Model)
+	if record.EndedAt != nil {
+		om.streamMu.Lock()
+		om.durationMs = record.EndedAt.Sub(record.StartedAt).Milliseconds()
+		om.streamMu.Unlock()
+	}
+
+	// Replaying queues UI updates, so it must not run on the UI goroutine
+	go func() {
+		for _, event := range record.Events {
+			om.AppendEvent(event)
+		}
+		for _, line := range record.Lines {
+			om.AppendRawLine(line)
+		}
+		om.spinner.Stop()
+		om.setStatusText(agentRunStatusText(record))
+		om.setSessionID(record.SessionID)
+		om.setResumeHint(record.ResumeCommand)
+	}()
+}
+
+// SetRun records the issue, provider, and model of the shown run so its
+// output can be posted to the issue. It must be called after Show.
+func (om *AgentOutputModal) SetRun(issue linearapi.Issue, provider, model string) {
+	om.streamMu.Lock()
+	defer om.streamMu.Unlock()
+	om.issue = issue
+	om.provider = provider
+	om.model = model
+}
+
 // AppendEvent appends a structured event to the stream view.
 func (om *AgentOutputModal) AppendEvent(event agents.AgentEvent) {
 	om.streamMu.Lock()
@@ -207,11 +272,19 @@ func (om *AgentOutputModal) AppendEvent(event agents.AgentEvent) {
 	update := om.buffer.Append(event)
 	if len(update.Lines) > 0 {
 		om.pending = append(om.pending, update.Lines...)
+		om.transcript = append(om.transcript, update.Lines...)
 	}
 	if update.Done {
 		om.spinner.Stop()
 		om.statusText = "Status: Completed"
 		finalText := update.FinalText
+		if finalText == "" {
+			finalText = strings.TrimSpace(event.Text)
+		}
+		om.finalText = finalText
+		if event.DurationMs > 0 {
+			om.durationMs = event.DurationMs
+		}
 		om.streamMu.Unlock()
 		om.renderFinal(finalText)
 		return
@@ -244,6 +317,7 @@ func (om *AgentOutputModal) AppendRawLine(line string) {
 	}
 	om.streamMu.Lock()
 	om.pending = append(om.pending, StreamLine{Kind: StreamLineUnknown, Text: line})
+	om.transcript = append(om.transcript, StreamLine{Kind: StreamLineUnknown, Text: line})
 	om.streamMu.Unlock()
 }
 
@@ -281,6 +355,10 @@ func (om *AgentOutputModal) HandleKey(event *tcell.EventKey) *tcell.EventKey {
 			om.copyResumeCommand()
 			return nil
 		}
+		if event.Rune() == 'p' {
+			om.postToIssue()
+			return nil
+		}
 	}
 	return event
 }
@@ -328,6 +406,165 @@ func (om *AgentOutputModal) copyResumeCommand() {
 	}
 }
 
+// postToIssue asks which part of the output to post and opens it, headed by
+// the run details, in the comment modal for review.
+func (om *AgentOutputModal) postToIssue() {
+	if om.spinner.Running() {
+		om.app.updateStatusBarWithError(fmt.Errorf("wait for the agent run to finish"))
+		return
+	}
+
+	om.streamMu.Lock()
+	issue := om.issue
+	header := formatAgentCommentHeader(om.provider, om.model, om.durationMs)
+	finalText := om.finalText
+	transcript := append([]StreamLine(nil), om.transcript...)
+	om.streamMu.Unlock()
+
+	if issue.ID == "" {
+		om.app.updateStatusBarWithError(fmt.Errorf("agent run is not linked to an issue"))
+		return
+	}
+
+	items := []PickerItem{}
+	if finalText != "" {
+		items = append(items, PickerItem{ID: agentPostFinal, Label: "Final result"})
+	}
+	if len(transcript) > 0 {
+		items = append(items,
+			PickerItem{ID: agentPostRange, Label: "Part of the transcript..."},
+			PickerItem{ID: agentPostTranscript, Label: "Full transcript"},
+		)
+	}
+	if len(items) == 0 {
+		om.app.updateStatusBarWithError(fmt.Errorf("agent run has no output to post"))
+		return
+	}
+
+	post := func(body string) {
+		om.Hide()
+		title := "Post Agent Result"
+		if issue.Identifier != "" {
+			title = "Post Agent Result to " + issue.Identifier
+		}
+		om.app.createCommentModal.ShowWithText(issue, title, title, header+"\n\n"+body, om.app.handleCreateComment)
+	}
+
+	om.app.pickerActive = true
+	om.app.pickerModal.Show("Post to Issue", items, func(item PickerItem) {
+		om.app.pickerActive = false
+		switch item.ID {
+		case agentPostFinal:
+			post(finalText)
+		case agentPostRange:
+			om.pickTranscriptRange(transcript, func(lines []StreamLine) {
+				post(formatAgentTranscript(lines, ""))
+			})
+		case agentPostTranscript:
+			post(formatAgentTranscript(transcript, finalText))
+		}
+	})
+}
+
+// pickTranscriptRange asks for the first and last transcript line to post and
+// passes the lines between them, inclusive, to onPick.
+func (om *AgentOutputModal) pickTranscriptRange(transcript []StreamLine, onPick func([]StreamLine)) {
+	om.app.pickerActive = true
+	om.app.pickerModal.Show("Post From Line", agentTranscriptItems(transcript, 0), func(first PickerItem) {
+		start, _ := strconv.Atoi(first.ID)
+		om.app.pickerActive = true
+		om.app.pickerModal.Show("Post Through Line", agentTranscriptItems(transcript, start), func(last PickerItem) {
+			om.app.pickerActive = false
+			end, _ := strconv.Atoi(last.ID)
+			onPick(transcript[start : end+1])
+		})
+	})
+}
+
+// agentTranscriptItems lists the non-empty transcript lines from index from
+// on as picker items whose IDs are the line indexes.
+func agentTranscriptItems(transcript []StreamLine, from int) []PickerItem {
+	items := []PickerItem{}
+	for i := from; i < len(transcript); i++ {
+		text := strings.Join(strings.Fields(transcript[i].Text), " ")
+		if text == "" {
+			continue
+		}
+		if runes := []rune(text); len(runes) > maxAgentTranscriptLabel {
+			text = string(runes[:maxAgentTranscriptLabel-1]) + "…"
+		}
+		items = append(items, PickerItem{ID: strconv.Itoa(i), Label: fmt.Sprintf("%3d  %s", i+1, text)})
+	}
+	return items
+}
+
+// formatAgentCommentHeader returns the first line of a posted agent result,
+// naming the provider, model, and run duration.
+func formatAgentCommentHeader(provider, model string, durationMs int64) string {
+	parts := []string{"**Agent result**"}
+	if provider != "" {
+		parts = append(parts, provider)
+	}
+	if model != "" {
+		parts = append(parts, "`"+model+"`")
+	}
+	if durationMs > 0 {
+		parts = append(parts, (time.Duration(durationMs) * time.Millisecond).Round(time.Second).String())
+	}
+	return strings.Join(parts, " · ")
+}
+
+// formatAgentTranscript renders transcript lines and the final result as
+// markdown. Consecutive tool calls form one list.
+func formatAgentTranscript(lines []StreamLine, finalText string) string {
+	blocks := []string{}
+	toolList := false
+	for _, line := range lines {
+		text := strings.TrimSpace(line.Text)
+		if text == "" {
+			continue
+		}
+		switch line.Kind {
+		case StreamLineThinking:
+			blocks = append(blocks, "> "+strings.ReplaceAll(text, "\n", "\n> "))
+		case StreamLineTool:
+			item := "- " + markdownCodeSpan(text)
+			if toolList {
+				blocks[len(blocks)-1] += "\n" + item
+			} else {
+				blocks = append(blocks, item)
+			}
+		default:
+			blocks = append(blocks, text)
+		}
+		toolList = line.Kind == StreamLineTool
+	}
+	if finalText != "" {
+		blocks = append(blocks, finalText)
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// markdownCodeSpan wraps text in a code span whose backtick fence is longer
+// than any backtick run in text, so backticks in text cannot end it early.
+func markdownCodeSpan(text string) string {
+	longest, run := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		// A leading or trailing backtick would join the fence
+		text = " " + text + " "
+	}
+	return fence + text + fence
+}
+
 // setStatusText updates the status text safely.
 func (om *AgentOutputModal) setStatusText(text string) {
 	om.streamMu.Lock()
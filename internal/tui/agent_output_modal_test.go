@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatAgentCommentHeader(t *testing.T) {
+	tests := []struct {
+		provider   string
+		model      string
+		durationMs int64
+		want       string
+	}{
+		{provider: "Claude", model: "sonnet", durationMs: 83_400, want: "**Agent result** · Claude · `sonnet` · 1m23s"},
+		{provider: "Codex", want: "**Agent result** · Codex"},
+		{want: "**Agent result**"},
+	}
+	for _, tt := range tests {
+		if got := formatAgentCommentHeader(tt.provider, tt.model, tt.durationMs); got != tt.want {
+			t.Errorf("formatAgentCommentHeader(%q, %q, %d) = %q, want %q", tt.provider, tt.model, tt.durationMs, got, tt.want)
+		}
+	}
+}
+
+func TestFormatAgentTranscript(t *testing.T) {
+	lines := []StreamLine{
+		{Kind: StreamLineThinking, Text: "Looking at the handler\nand its tests"},
+		{Kind: StreamLineTool, Text: "Tool call completed: read (main.go)"},
+		{Kind: StreamLineTool, Text: "Tool call completed: exec (go test)"},
+		{Kind: StreamLineUnknown, Text: "  "},
+		{Kind: StreamLineUnknown, Text: "warning: slow test"},
+	}
+
+	want := "> Looking at the handler\n> and its tests\n\n" +
+		"- `Tool call completed: read (main.go)`\n" +
+		"- `Tool call completed: exec (go test)`\n\n" +
+		"warning: slow test\n\n" +
+		"The handler ignores the timeout."
+	if got := formatAgentTranscript(lines, "The handler ignores the timeout."); got != want {
+		t.Errorf("formatAgentTranscript() = %q, want %q", got, want)
+	}
+
+	if got := formatAgentTranscript(nil, ""); got != "" {
+		t.Errorf("formatAgentTranscript(nil) = %q, want empty", got)
+	}
+
+	tool := []StreamLine{{Kind: StreamLineTool, Text: "Tool call completed: exec (grep `foo` main.go)"}}
+	if got, want := formatAgentTranscript(tool, ""), "- ``Tool call completed: exec (grep `foo` main.go)``"; got != want {
+		t.Errorf("formatAgentTranscript(backticks) = %q, want %q", got, want)
+	}
+}
+
+func TestMarkdownCodeSpan(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "go test", want: "`go test`"},
+		{text: "echo `date`", want: "`` echo `date` ``"},
+		{text: "a ``b`` c", want: "```a ``b`` c```"},
+		{text: "`x", want: "`` `x ``"},
+	}
+	for _, tt := range tests {
+		if got := markdownCodeSpan(tt.text); got != tt.want {
+			t.Errorf("markdownCodeSpan(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestAgentTranscriptItems(t *testing.T) {
+	lines := []StreamLine{
+		{Kind: StreamLineThinking, Text: "Looking at\nthe handler"},
+		{Kind: StreamLineUnknown, Text: "  "},
+		{Kind: StreamLineTool, Text: "Tool call completed: read (" + strings.Repeat("x", 40) + ")"},
+	}
+
+	items := agentTranscriptItems(lines, 0)
+	if len(items) != 2 {
+		t.Fatalf("agentTranscriptItems() = %+v, want 2 non-empty lines", items)
+	}
+	if items[0].ID != "0" || items[0].Label != "  1  Looking at the handler" {
+		t.Errorf("items[0] = %+v", items[0])
+	}
+	if items[1].ID != "2" || !strings.HasPrefix(items[1].Label, "  3  Tool call") || !strings.HasSuffix(items[1].Label, "…") {
+		t.Errorf("items[1] = %+v, want truncated line 3", items[1])
+	}
+
+	if items := agentTranscriptItems(lines, 2); len(items) != 1 || items[0].ID != "2" {
+		t.Errorf("agentTranscriptItems(from 2) = %+v, want only line 3", items)
+	}
+}
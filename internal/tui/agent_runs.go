@@ -0,0 +1,275 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Actions offered for a past agent run.
+const (
+	agentRunOpen   = "open"
+	agentRunResume = "resume"
+	agentRunRerun  = "rerun"
+)
+
+// maxAgentRunPromptLabel is the prompt length shown in the runs list.
+const maxAgentRunPromptLabel = 48
+
+// agentRunRequest describes an agent run to start.
+type agentRunRequest struct {
+	IssueID   string
+	Prompt    string
+	Workspace string
+	Provider  string
+	Model     string
+	Sandbox   string
+}
+
+// SetAgentRunStore enables persisting agent runs to store. It should be
+// called before Run.
+func (a *App) SetAgentRunStore(store *agents.RunStore) {
+	a.agentRuns = store
+}
+
+// runAgent fetches the issue, runs the agent, and streams its output to the
+// output modal, recording the run when a run store is set. It blocks until
+// the run ends and must not be called on the UI goroutine.
+func (a *App) runAgent(request agentRunRequest) {
+	fullIssue, err := a.services().fetchIssueByID(context.Background(), request.IssueID)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.commands: failed to fetch issue for agent issue_id=%s", request.IssueID)
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(err)
+		})
+		return
+	}
+
+	issueContext := agents.BuildIssueContext(fullIssue)
+	runner := a.agentRunner
+
+	selected, err := agents.ProviderForKey(request.Provider, a.config.AgentCommands, runner.LookPath)
+	if err != nil {
+		logger.Error("tui.commands: invalid agent provider provider=%s", request.Provider)
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(err)
+		})
+		return
+	}
+
+	if _, ok := selected.ResolveBinary(); !ok {
+		logger.Error("tui.commands: agent binary not found provider=%s", selected.Name())
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(fmt.Errorf("agent binary not found for %s", selected.Name()))
+		})
+		return
+	}
+
+	options := agents.AgentRunOptions{
+		Workspace: request.Workspace,
+		Model:     request.Model,
+		Sandbox:   request.Sandbox,
+	}
+
+	var recorder *agents.RunRecorder
+	if a.agentRuns != nil {
+		recorder, err = a.agentRuns.Start(agents.RunRecord{
+			IssueID:         fullIssue.ID,
+			IssueIdentifier: fullIssue.Identifier,
+			Provider:        strings.ToLower(strings.TrimSpace(request.Provider)),
+			ProviderName:    selected.Name(),
+			Model:           request.Model,
+			Sandbox:         request.Sandbox,
+			Prompt:          request.Prompt,
+			Workspace:       request.Workspace,
+		})
+		if err != nil {
+			// The run itself does not depend on its transcript being saved
+			logger.ErrorWithErr(err, "tui.commands: failed to record agent run issue=%s", fullIssue.Identifier)
+			recorder = nil
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.QueueUpdateDraw(func() {
+		title := fmt.Sprintf(" %s Output ", selected.Name())
+		a.agentOutputModal.Show(title, cancel)
+		a.agentOutputModal.SetRun(fullIssue, selected.Name(), request.Model)
+		a.agentOutputModal.AppendLine(fmt.Sprintf("Starting %s agent run...", selected.Name()))
+	})
+
+	runErr := runner.Run(ctx, selected, request.Prompt, issueContext, options, func(event agents.AgentEvent) {
+		if recorder != nil {
+			recorder.AddEvent(event)
+		}
+		a.agentOutputModal.AppendEvent(event)
+	}, func(line string) {
+		if recorder != nil {
+			recorder.AddLine(line)
+		}
+		a.agentOutputModal.AppendRawLine(line)
+	}, func(runErr error) {
+		a.agentOutputModal.AppendLine(fmt.Sprintf("error: %v", runErr))
+	})
+
+	if recorder != nil {
+		if record, err := recorder.Finish(ctx, runErr); err != nil {
+			logger.ErrorWithErr(err, "tui.commands: failed to save agent run id=%s", record.ID)
+		} else {
+			logger.Info("tui.commands: saved agent run id=%s issue=%s status=%s", record.ID, record.IssueIdentifier, record.Status)
+		}
+	}
+	cancel()
+
+	a.agentOutputModal.StopSpinner()
+
+	if runErr != nil {
+		a.QueueUpdateDraw(func() {
+			a.agentOutputModal.AppendLine(fmt.Sprintf("error: %v", runErr))
+		})
+		return
+	}
+
+	a.agentOutputModal.AppendLine("Agent run completed.")
+}
+
+// showAgentRuns lists the past agent runs of the selected issue, or of all
+// issues when none is selected.
+func (a *App) showAgentRuns() {
+	if a.agentRuns == nil {
+		a.updateStatusBarWithError(fmt.Errorf("agent run history is not available"))
+		return
+	}
+
+	issueID := ""
+	title := "Agent Runs"
+	if issue := a.GetSelectedIssue(); issue != nil {
+		issueID = issue.ID
+		title = "Agent Runs - " + issue.Identifier
+	}
+
+	go func() {
+		records, err := a.agentRuns.List(issueID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.commands: failed to list agent runs")
+				a.updateStatusBarWithError(err)
+				return
+			}
+			if len(records) == 0 {
+				a.statusBar.SetText(a.themeTags.SecondaryText + "No agent runs yet[-]")
+				return
+			}
+
+			byID := make(map[string]agents.RunRecord, len(records))
+			items := make([]PickerItem, 0, len(records))
+			for _, record := range records {
+				byID[record.ID] = record
+				items = append(items, PickerItem{
+					ID:    record.ID,
+					Label: formatAgentRunLabel(record, issueID == ""),
+				})
+			}
+
+			a.pickerActive = true
+			a.pickerModal.Show(title, items, func(item PickerItem) {
+				a.pickerActive = false
+				a.showAgentRunActions(byID[item.ID])
+			})
+		})
+	}()
+}
+
+// showAgentRunActions offers to reopen, resume, or re-run a past run.
+func (a *App) showAgentRunActions(record agents.RunRecord) {
+	items := []PickerItem{{ID: agentRunOpen, Label: "Open transcript"}}
+	if record.ResumeCommand != "" {
+		items = append(items, PickerItem{ID: agentRunResume, Label: "Copy resume command"})
+	}
+	items = append(items, PickerItem{ID: agentRunRerun, Label: "Re-run with the same prompt"})
+
+	a.pickerActive = true
+	a.pickerModal.Show("Agent Run", items, func(item PickerItem) {
+		a.pickerActive = false
+		switch item.ID {
+		case agentRunOpen:
+			a.openAgentRunTranscript(record)
+		case agentRunResume:
+			if err := copyToClipboard(record.ResumeCommand); err != nil {
+				a.updateStatusBarWithError(err)
+			}
+		case agentRunRerun:
+			a.rerunAgent(record)
+		}
+	})
+}
+
+// openAgentRunTranscript shows a past run in the output modal.
+func (a *App) openAgentRunTranscript(record agents.RunRecord) {
+	if a.agentOutputModal == nil {
+		a.agentOutputModal = NewAgentOutputModal(a)
+	}
+	a.agentOutputModal.ShowTranscript(record)
+}
+
+// rerunAgent starts a new run with the provider, model, prompt, and
+// workspace of a past run.
+func (a *App) rerunAgent(record agents.RunRecord) {
+	if a.agentOutputModal == nil {
+		a.agentOutputModal = NewAgentOutputModal(a)
+	}
+	if a.agentRunner == nil {
+		a.agentRunner = agents.NewRunner()
+	}
+
+	logger.Info("tui.commands: re-running agent run id=%s issue=%s", record.ID, record.IssueIdentifier)
+	go a.runAgent(agentRunRequest{
+		IssueID:   record.IssueID,
+		Prompt:    record.Prompt,
+		Workspace: record.Workspace,
+		Provider:  record.Provider,
+		Model:     record.Model,
+		Sandbox:   record.Sandbox,
+	})
+}
+
+// formatAgentRunLabel renders a run as a single picker line.
+func formatAgentRunLabel(record agents.RunRecord, withIssue bool) string {
+	parts := []string{record.StartedAt.Local().Format("Jan 02 15:04")}
+	if withIssue && record.IssueIdentifier != "" {
+		parts = append(parts, record.IssueIdentifier)
+	}
+	name := record.ProviderName
+	if name == "" {
+		name = record.Provider
+	}
+	parts = append(parts, name, record.Status)
+
+	prompt := strings.Join(strings.Fields(record.Prompt), " ")
+	if runes := []rune(prompt); len(runes) > maxAgentRunPromptLabel {
+		prompt = string(runes[:maxAgentRunPromptLabel-1]) + "…"
+	}
+	parts = append(parts, prompt)
+	return strings.Join(parts, "  ")
+}
+
+// agentRunStatusText returns the output modal status line of a past run.
+func agentRunStatusText(record agents.RunRecord) string {
+	switch record.Status {
+	case agents.RunStatusCompleted:
+		return "Status: Completed"
+	case agents.RunStatusCanceled:
+		return "Status: Canceled"
+	case agents.RunStatusFailed:
+		if record.Error != "" {
+			return "Status: Failed - " + record.Error
+		}
+		return "Status: Failed"
+	default:
+		// The app exited before the run finished
+		return "Status: Interrupted"
+	}
+}
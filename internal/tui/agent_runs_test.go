@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/agents"
+)
+
+func TestFormatAgentRunLabel(t *testing.T) {
+	record := agents.RunRecord{
+		IssueIdentifier: "ENG-7",
+		Provider:        "codex",
+		ProviderName:    "Codex",
+		Prompt:          "Fix the\nlogin  bug " + strings.Repeat("x", 60),
+		StartedAt:       time.Date(2025, 3, 4, 9, 5, 0, 0, time.Local),
+		Status:          agents.RunStatusCompleted,
+	}
+
+	got := formatAgentRunLabel(record, false)
+	if !strings.HasPrefix(got, "Mar 04 09:05  Codex  completed  Fix the login bug x") {
+		t.Errorf("formatAgentRunLabel() = %q", got)
+	}
+	if !strings.HasSuffix(got, "…") || strings.Contains(got, "ENG-7") {
+		t.Errorf("formatAgentRunLabel() = %q, want truncated prompt without issue", got)
+	}
+
+	record.ProviderName = ""
+	if got := formatAgentRunLabel(record, true); !strings.Contains(got, "ENG-7  codex  completed") {
+		t.Errorf("formatAgentRunLabel(withIssue) = %q", got)
+	}
+}
+
+func TestAgentRunStatusText(t *testing.T) {
+	tests := []struct {
+		record agents.RunRecord
+		want   string
+	}{
+		{record: agents.RunRecord{Status: agents.RunStatusCompleted}, want: "Status: Completed"},
+		{record: agents.RunRecord{Status: agents.RunStatusCanceled}, want: "Status: Canceled"},
+		{record: agents.RunRecord{Status: agents.RunStatusFailed, Error: "exit status 1"}, want: "Status: Failed - exit status 1"},
+		{record: agents.RunRecord{Status: agents.RunStatusRunning}, want: "Status: Interrupted"},
+	}
+	for _, tt := range tests {
+		if got := agentRunStatusText(tt.record); got != tt.want {
+			t.Errorf("agentRunStatusText(%s) = %q, want %q", tt.record.Status, got, tt.want)
+		}
+	}
+}
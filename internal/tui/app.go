@@ -2,11 +2,14 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -15,6 +18,7 @@ import (
 	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/offline"
 )
 
 // SortField represents a field to sort issues by.
@@ -28,9 +32,14 @@ const (
 
 // App is the main application controller that manages all UI components.
 type App struct {
-	app       *tview.Application
-	api       *linearapi.Client
-	cache     *cache.TeamCache
+	app *tview.Application
+
+	// servicesMu guards api, cache, issueStore, offlineQueue, and the fetch
+	// helpers, which an account switch replaces while background work uses them
+	servicesMu sync.RWMutex
+	api        *linearapi.Client
+	cache      *cache.TeamCache
+
 	config    config.Config
 	theme     Theme
 	themeTags ThemeTags
@@ -44,6 +53,7 @@ type App struct {
 	myIssuesTable          *tview.Table
 	otherIssuesTable       *tview.Table
 	issuesColumn           *tview.Flex     // Vertical flex containing My/Other tables
+	issuesBoard            *tview.Table    // Board shown instead of the tables in board layout
 	detailsView            *tview.Flex     // Flex container for details (description + comments)
 	detailsDescriptionView *tview.TextView // Scrollable description/metadata view
 	detailsCommentsView    *tview.TextView // Scrollable comments view
@@ -57,13 +67,20 @@ type App struct {
 	createIssueModal       *CreateIssueModal
 	createCommentModal     *CreateCommentModal
 	editTitleModal         *EditTitleModal
+	textInputModal         *TextInputModal
 	editLabelsModal        *EditLabelsModal
 	settingsModal          *SettingsModal
 	promptTemplatesModal   *AgentPromptTemplatesModal
 	agentPromptModal       *AgentPromptModal
 	agentOutputModal       *AgentOutputModal
+	inboxModal             *InboxModal
 	agentRunner            *agents.Runner
+	agentRuns              *agents.RunStore // Persisted agent run transcripts; nil disables history
 	agentPromptTemplates   []config.AgentPromptTemplate
+	savedViews             []config.SavedView
+	viewsNode              *tview.TreeNode // "Views" section of the navigation tree
+	inboxNode              *tview.TreeNode // "Inbox" node showing the unread count
+	notifications          []linearapi.Notification
 
 	// App state (protected by issuesMu)
 	issuesMu            sync.RWMutex
@@ -85,8 +102,42 @@ type App struct {
 	expandedState  map[string]bool             // Expanded state for parent issues (shared across sections)
 
 	// Filter/sort state
-	searchQuery string
-	sortField   SortField
+	searchQuery    string
+	searchQueryErr error // Syntax error in the query being typed in the search palette
+	sortField      SortField
+
+	// Multi-select state for bulk operations
+	markedIssueIDs   map[string]bool // Issues marked in either issues table
+	visualAnchorID   string          // Issue where visual range selection started ("" = off)
+	visualSection    IssuesSection   // Section the visual range selection is in
+	visualBaseMarked map[string]bool // Marks that existed before visual mode started
+	bulkStatus       string          // Progress or result of the last bulk operation
+
+	// Board layout state: columns by workflow state and the card under the cursor
+	boardColumns       []boardColumn
+	boardCursorCol     int
+	boardCursorRow     int
+	boardStatesLoading atomic.Bool
+
+	// On-disk issue snapshots for instant startup and delta sync (nil = disabled)
+	issueStore *cache.DiskStore
+
+	// Mutations queued while the API is unreachable (nil = disabled)
+	offlineQueue     *offline.Queue
+	offlineReplaying atomic.Bool
+
+	// Rate limit reset time shown as a countdown in the status bar
+	rateLimitedUntil time.Time
+	rateLimitTicking atomic.Bool
+
+	// Background refresh: rows added or changed by recent refreshes are marked
+	// until the highlight fades, and the status bar shows the list's age
+	stopLiveUpdates func()               // Stops the webhook receiver or poller
+	lastRefreshAt   time.Time            // When the issue list was last refreshed
+	changedIssueIDs map[string]time.Time // Issue ID -> when its change was seen
+	changeScope     string               // List the change baseline belongs to
+	changeBaseline  map[string]time.Time // Issue ID -> UpdatedAt before the current refresh
+	statusBarText   string               // Last text set by updateStatusBar
 
 	// Cached metadata for currently selected team
 	currentUser    *linearapi.User
@@ -113,8 +164,9 @@ type App struct {
 	fetchingIssueID string // Tracks which issue ID we're currently fetching
 
 	// Details pane sub-view focus
-	focusedDetailsView     bool // false = description, true = comments
-	detailsCommentsVisible bool // Tracks whether comments view is shown
+	focusedDetailsView     bool   // false = description, true = comments
+	detailsCommentsVisible bool   // Tracks whether comments view is shown
+	focusedCommentID       string // Comment highlighted in the comments view ("" = none)
 }
 
 // FocusTarget indicates which pane has focus.
@@ -147,6 +199,8 @@ func NewApp(api *linearapi.Client, cfg config.Config, templates []config.AgentPr
 		focusedPane:          FocusNavigation,
 		sortField:            SortByUpdatedAt,
 		expandedState:        make(map[string]bool),
+		markedIssueIDs:       make(map[string]bool),
+		changedIssueIDs:      make(map[string]time.Time),
 		idToIssue:            make(map[string]*linearapi.Issue),
 		myIDToIssue:          make(map[string]*linearapi.Issue),
 		otherIDToIssue:       make(map[string]*linearapi.Issue),
@@ -175,6 +229,10 @@ func (a *App) Run() error {
 
 	// Load initial data asynchronously
 	a.loadInitialData()
+	a.startOfflineReplay()
+	a.stopLiveUpdates = a.startLiveUpdates()
+	defer func() { a.stopLiveUpdates() }()
+	a.startLiveStatusTicker()
 
 	// Start the application event loop
 	return a.app.Run()
@@ -186,7 +244,7 @@ func (a *App) loadInitialData() {
 		ctx := context.Background()
 
 		// Fetch current user first
-		user, err := a.cache.GetCurrentUser(ctx)
+		user, err := a.GetCache().GetCurrentUser(ctx)
 		if err == nil {
 			a.currentUser = &user
 			logger.Debug("tui.app: current user loaded user=%s", user.DisplayName)
@@ -196,6 +254,7 @@ func (a *App) loadInitialData() {
 
 		// Fetch teams and build navigation
 		a.loadNavigationData(ctx)
+		a.loadNotifications()
 
 		// Load issues for initial view
 		a.refreshIssues()
@@ -204,8 +263,15 @@ func (a *App) loadInitialData() {
 
 // applySettings updates runtime dependencies to match a new configuration.
 func (a *App) applySettings(newCfg config.Config) {
+	liveUpdatesChanged := newCfg.PollInterval != a.config.PollInterval ||
+		newCfg.WebhookAddr != a.config.WebhookAddr ||
+		newCfg.WebhookSecret != a.config.WebhookSecret
 	a.config = newCfg
 	a.applyThemeAndDensity()
+	if liveUpdatesChanged && a.stopLiveUpdates != nil {
+		a.stopLiveUpdates()
+		a.stopLiveUpdates = a.startLiveUpdates()
+	}
 
 	logLevel := parseLogLevel(newCfg.LogLevel)
 	if err := logger.Reinit(newCfg.LogFile, logLevel); err != nil {
@@ -217,20 +283,35 @@ func (a *App) applySettings(newCfg config.Config) {
 	}
 	logger.Debug("tui.app: settings applied log_file=%s log_level=%s", newCfg.LogFile, newCfg.LogLevel)
 
-	a.api = linearapi.NewClient(linearapi.ClientConfig{
-		Token:    newCfg.LinearAPIKey,
-		Endpoint: newCfg.APIEndpoint,
-		Timeout:  newCfg.Timeout,
-	})
-	a.cache = cache.NewTeamCache(a.api, newCfg.CacheTTL)
-	a.fetchIssuesPage = a.api.FetchIssuesPage
-	a.fetchIssueByID = a.api.FetchIssueByID
+	a.rebuildAPI(newCfg)
 
 	logger.Debug("tui.app: resetting cached state after settings change")
 	a.resetCachedState()
 	a.loadInitialData()
 }
 
+// rebuildAPI replaces the API client and team cache with ones built for cfg.
+func (a *App) rebuildAPI(cfg config.Config) {
+	api := linearapi.NewClient(linearapi.ClientConfig{
+		Token:        cfg.LinearAPIKey,
+		Endpoint:     cfg.Endpoint(),
+		Timeout:      cfg.Timeout,
+		RefreshToken: cfg.APIKeySource.RefreshFunc(),
+		TokenSource:  cfg.TokenSource,
+	})
+	teamCache := cache.NewTeamCache(api, cfg.CacheTTL)
+
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+	if a.issueStore != nil {
+		teamCache.Restore(a.issueStore.Metadata())
+	}
+	a.api = api
+	a.cache = teamCache
+	a.fetchIssuesPage = api.FetchIssuesPage
+	a.fetchIssueByID = api.FetchIssueByID
+}
+
 func (a *App) applyThemeAndDensity() {
 	a.theme = ResolveTheme(a.config.Theme)
 	a.themeTags = NewThemeTags(a.theme)
@@ -269,11 +350,15 @@ func (a *App) applyThemeToComponents() {
 
 	if a.myIssuesTable != nil {
 		a.applyIssuesTableTheme(a.myIssuesTable)
-		renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, a.selectedIssueID(IssuesSectionMy), a.theme)
+		renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, a.selectedIssueID(IssuesSectionMy), a.markedIssueIDs, a.changedIssueIDs, a.theme)
 	}
 	if a.otherIssuesTable != nil {
 		a.applyIssuesTableTheme(a.otherIssuesTable)
-		renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, a.selectedIssueID(IssuesSectionOther), a.theme)
+		renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, a.selectedIssueID(IssuesSectionOther), a.markedIssueIDs, a.changedIssueIDs, a.theme)
+	}
+	if a.issuesBoard != nil {
+		a.applyIssuesTableTheme(a.issuesBoard)
+		a.renderIssuesBoard()
 	}
 
 	if a.detailsDescriptionView != nil {
@@ -323,10 +408,12 @@ func (a *App) rebuildModals() {
 	a.createIssueModal = NewCreateIssueModal(a)
 	a.createCommentModal = NewCreateCommentModal(a)
 	a.editTitleModal = NewEditTitleModal(a)
+	a.textInputModal = NewTextInputModal(a)
 	a.editLabelsModal = NewEditLabelsModal(a)
 	a.settingsModal = NewSettingsModal(a)
 	a.promptTemplatesModal = NewAgentPromptTemplatesModal(a)
 	a.agentPromptModal = NewAgentPromptModal(a)
+	a.inboxModal = NewInboxModal(a)
 	if a.pages == nil || !a.pages.HasPage("agent_output") {
 		a.agentOutputModal = NewAgentOutputModal(a)
 	} else {
@@ -367,7 +454,7 @@ func (a *App) applyNavigationNodeColors(node *tview.TreeNode) {
 	if ref == nil {
 		node.SetColor(a.theme.Accent)
 	} else if navNode, ok := ref.(*NavigationNode); ok {
-		if navNode.IsProject || navNode.IsStatus {
+		if navNode.IsProject || navNode.IsStatus || navNode.IsCycle {
 			node.SetColor(a.theme.SecondaryText)
 		} else {
 			node.SetColor(a.theme.Foreground)
@@ -447,7 +534,7 @@ func parseLogLevel(level string) logger.LogLevel {
 
 // loadNavigationData fetches teams and projects from the API and updates the navigation tree.
 func (a *App) loadNavigationData(ctx context.Context) {
-	teams, err := a.cache.GetTeams(ctx)
+	teams, err := a.GetCache().GetTeams(ctx)
 	if err != nil {
 		logger.ErrorWithErr(err, "tui.app: failed to load teams")
 		a.app.QueueUpdateDraw(func() {
@@ -457,18 +544,28 @@ func (a *App) loadNavigationData(ctx context.Context) {
 	}
 
 	logger.Debug("tui.app: loaded teams count=%d", len(teams))
+	// Wait for the tree so the first issue refresh sees the default team
+	done := make(chan struct{})
 	a.app.QueueUpdateDraw(func() {
 		a.rebuildNavigationTree(teams)
+		close(done)
 	})
+	<-done
 }
 
 // rebuildNavigationTree rebuilds the navigation tree with real data.
 func (a *App) rebuildNavigationTree(teams []linearapi.Team) {
-	root := tview.NewTreeNode("Linear").
+	root := tview.NewTreeNode(navigationRootLabel(a.config.Profile)).
 		SetColor(a.theme.Accent).
 		SetSelectable(false)
 
-	// Add "All Issues" at the top
+	// Add the notifications inbox at the top
+	a.inboxNode = tview.NewTreeNode(inboxLabel(unreadNotificationCount(a.notifications, time.Now()))).
+		SetColor(a.theme.Foreground).
+		SetReference(&NavigationNode{ID: "inbox", Text: "Inbox", IsInbox: true})
+	root.AddChild(a.inboxNode)
+
+	// Add "All Issues" below the inbox
 	allIssues := tview.NewTreeNode("All Issues").
 		SetColor(a.theme.Foreground).
 		SetReference(&NavigationNode{ID: "all", Text: "All Issues"}).
@@ -476,6 +573,8 @@ func (a *App) rebuildNavigationTree(teams []linearapi.Team) {
 	root.AddChild(allIssues)
 
 	// Add teams
+	defaultTeam, hasDefaultTeam := findTeam(teams, a.config.DefaultTeam)
+	var defaultTeamNode *tview.TreeNode
 	for _, team := range teams {
 		teamNode := tview.NewTreeNode(team.Name).
 			SetColor(a.theme.Foreground).
@@ -491,9 +590,35 @@ func (a *App) rebuildNavigationTree(teams []linearapi.Team) {
 		// Do NOT set SetSelectedFunc here as it causes duplicate callbacks
 
 		root.AddChild(teamNode)
+		if hasDefaultTeam && team.ID == defaultTeam.ID {
+			defaultTeamNode = teamNode
+		}
 	}
 
 	a.navigationTree.SetRoot(root)
+
+	// Add saved views below "All Issues"
+	a.viewsNode = tview.NewTreeNode("Views").
+		SetColor(a.theme.Accent).
+		SetSelectable(false).
+		SetExpanded(true)
+	a.renderViewsSection()
+
+	// Keep a saved view selected (e.g. the startup default view)
+	if a.selectedNavigation != nil && a.selectedNavigation.IsView {
+		if node := a.findViewTreeNode(a.selectedNavigation.ViewName); node != nil {
+			a.navigationTree.SetCurrentNode(node)
+			return
+		}
+	}
+
+	// Start on the account's default team when one is configured
+	if defaultTeamNode != nil {
+		a.navigationTree.SetCurrentNode(defaultTeamNode)
+		a.selectedNavigation = defaultTeamNode.GetReference().(*NavigationNode)
+		return
+	}
+
 	a.navigationTree.SetCurrentNode(allIssues)
 	a.selectedNavigation = &NavigationNode{ID: "all", Text: "All Issues"}
 }
@@ -506,22 +631,28 @@ func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 		return
 	}
 
-	// Load projects and workflow states asynchronously
+	// Load projects, workflow states, and cycles asynchronously
 	go func() {
 		logger.Debug("tui.app: loading navigation children team_id=%s", teamID)
 		ctx := context.Background()
 		var projects []linearapi.Project
 		var states []linearapi.WorkflowState
-		var projectsErr, statesErr error
+		var cycles []linearapi.Cycle
+		var projectsErr, statesErr, cyclesErr error
+		teamCache := a.GetCache()
 		var wg sync.WaitGroup
-		wg.Add(2)
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			projects, projectsErr = teamCache.GetProjects(ctx, teamID)
+		}()
 		go func() {
 			defer wg.Done()
-			projects, projectsErr = a.cache.GetProjects(ctx, teamID)
+			states, statesErr = teamCache.GetWorkflowStates(ctx, teamID)
 		}()
 		go func() {
 			defer wg.Done()
-			states, statesErr = a.cache.GetWorkflowStates(ctx, teamID)
+			cycles, cyclesErr = teamCache.GetCycles(ctx, teamID)
 		}()
 		wg.Wait()
 		if projectsErr != nil {
@@ -538,7 +669,12 @@ func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 			})
 			return
 		}
-		logger.Debug("tui.app: loaded navigation children team_id=%s projects=%d states=%d", teamID, len(projects), len(states))
+		if cyclesErr != nil {
+			// Cycles are optional; keep the rest of the tree usable without them
+			logger.Warning("tui.app: failed to load cycles team_id=%s error=%v", teamID, cyclesErr)
+			cycles = nil
+		}
+		logger.Debug("tui.app: loaded navigation children team_id=%s projects=%d states=%d cycles=%d", teamID, len(projects), len(states), len(cycles))
 
 		a.app.QueueUpdateDraw(func() {
 			// Double-check children haven't been added by another goroutine
@@ -574,6 +710,35 @@ func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 				}
 				teamNode.AddChild(statusGroup)
 			}
+			if len(cycles) > 0 {
+				cycleGroup := tview.NewTreeNode("  Cycles").
+					SetColor(a.theme.SecondaryText).
+					SetSelectable(false).
+					SetReference(&NavigationNode{
+						ID:      fmt.Sprintf("%s-cycles", teamID),
+						Text:    "Cycles",
+						TeamID:  teamID,
+						IsCycle: true,
+					})
+				for _, cycle := range cycles {
+					label := cycleLabel(cycle.Number, cycle.Name)
+					text := label
+					if cycle.IsActive {
+						text += " (current)"
+					}
+					cycleNode := tview.NewTreeNode("    " + text).
+						SetColor(a.theme.SecondaryText).
+						SetReference(&NavigationNode{
+							ID:      cycle.ID,
+							Text:    label,
+							TeamID:  teamID,
+							IsCycle: true,
+							CycleID: cycle.ID,
+						})
+					cycleGroup.AddChild(cycleNode)
+				}
+				teamNode.AddChild(cycleGroup)
+			}
 			for _, proj := range projects {
 				projNode := tview.NewTreeNode("  " + proj.Name).
 					SetColor(a.theme.SecondaryText).
@@ -597,10 +762,15 @@ func (a *App) buildLayout() {
 	// Build My Issues and Other Issues tables
 	a.myIssuesTable = a.buildIssuesTable(" My Issues ", IssuesSectionMy)
 	a.otherIssuesTable = a.buildIssuesTable(" Other Issues ", IssuesSectionOther)
+	a.issuesBoard = a.buildIssuesBoard()
 	// Create vertical flex for issues column
 	a.issuesColumn = tview.NewFlex().SetDirection(tview.FlexRow)
 	// Initially show only Other Issues table (My Issues will be added when issues are loaded)
-	a.issuesColumn.AddItem(a.otherIssuesTable, 0, 1, false)
+	if a.isBoardLayout() {
+		a.issuesColumn.AddItem(a.issuesBoard, 0, 1, false)
+	} else {
+		a.issuesColumn.AddItem(a.otherIssuesTable, 0, 1, false)
+	}
 	// Legacy table for backward compatibility (will be removed after migration)
 	a.issuesTable = a.otherIssuesTable
 	a.detailsView = a.buildDetailsView()
@@ -626,11 +796,13 @@ func (a *App) buildLayout() {
 	a.createIssueModal = NewCreateIssueModal(a)
 	a.createCommentModal = NewCreateCommentModal(a)
 	a.editTitleModal = NewEditTitleModal(a)
+	a.textInputModal = NewTextInputModal(a)
 	a.editLabelsModal = NewEditLabelsModal(a)
 	a.settingsModal = NewSettingsModal(a)
 	a.promptTemplatesModal = NewAgentPromptTemplatesModal(a)
 	a.agentPromptModal = NewAgentPromptModal(a)
 	a.agentOutputModal = NewAgentOutputModal(a)
+	a.inboxModal = NewInboxModal(a)
 	a.agentRunner = agents.NewRunner()
 
 	// Add main layout to pages
@@ -664,6 +836,11 @@ func (a *App) bindGlobalKeys() {
 			return a.editTitleModal.HandleKey(event)
 		}
 
+		// Check if text input modal is visible and handle its keys
+		if a.pages.HasPage("text_input") && a.textInputModal != nil {
+			return a.textInputModal.HandleKey(event)
+		}
+
 		// Check if edit labels modal is visible and handle its keys
 		if a.pages.HasPage("edit_labels") && a.editLabelsModal != nil {
 			return a.editLabelsModal.HandleKey(event)
@@ -689,6 +866,11 @@ func (a *App) bindGlobalKeys() {
 			return a.agentOutputModal.HandleKey(event)
 		}
 
+		// Check if inbox modal is visible and handle its keys
+		if a.pages.HasPage("inbox") && a.inboxModal != nil {
+			return a.inboxModal.HandleKey(event)
+		}
+
 		// Handle palette first if it's open
 		if a.focusedPane == FocusPalette {
 			return a.handlePaletteKey(event)
@@ -800,6 +982,9 @@ func (a *App) handleNavigationKey(event *tcell.EventKey) *tcell.EventKey {
 
 // handleIssuesKey handles keyboard input when issues pane is focused.
 func (a *App) handleIssuesKey(event *tcell.EventKey) *tcell.EventKey {
+	if a.isBoardLayout() && a.handleBoardKey(event) {
+		return nil
+	}
 	switch event.Key() {
 	case tcell.KeyLeft:
 		a.focusedPane = FocusNavigation
@@ -823,6 +1008,10 @@ func (a *App) handleIssuesKey(event *tcell.EventKey) *tcell.EventKey {
 			a.focusedDetailsView = false // Start with description
 			a.updateFocus()
 			return nil
+		case '0', '1', '2', '3', '4':
+			// Quick-set priority (0 = none, 1 = urgent ... 4 = low)
+			a.setPriority(int(r - '0'))
+			return nil
 		}
 		// Handle command shortcuts (plain letters) - skip navigation keys
 		if r != 'j' && r != 'k' { // j/k are handled by table for up/down
@@ -850,6 +1039,25 @@ func (a *App) handleDetailsKey(event *tcell.EventKey) *tcell.EventKey {
 			a.updateFocus()
 			return nil
 		}
+		if a.isCommentsViewFocused() {
+			switch event.Rune() {
+			case 'n':
+				a.moveCommentFocus(1)
+				return nil
+			case 'p':
+				a.moveCommentFocus(-1)
+				return nil
+			case 'r':
+				a.ShowReplyToComment()
+				return nil
+			case 'e':
+				a.ShowEditComment()
+				return nil
+			case 'd':
+				a.ShowDeleteComment()
+				return nil
+			}
+		}
 	}
 	return event
 }
@@ -868,8 +1076,13 @@ func (a *App) handlePaletteKey(event *tcell.EventKey) *tcell.EventKey {
 		return nil
 	case tcell.KeyEnter:
 		if a.paletteCtrl.IsSearchMode() {
-			// In search mode, submit the search query
+			// In search mode, submit the search query unless it has a syntax error
 			query := a.paletteCtrl.Query()
+			a.updateSearchSuggestions()
+			if a.searchQueryErr != nil {
+				a.updatePaletteList()
+				return nil
+			}
 			a.closePaletteUI()      // Close UI without changing focus
 			a.setSearchQuery(query) // This will set focus to issues pane
 			return nil
@@ -882,34 +1095,39 @@ func (a *App) handlePaletteKey(event *tcell.EventKey) *tcell.EventKey {
 		}
 		return nil
 	case tcell.KeyUp:
-		if !a.paletteCtrl.IsSearchMode() {
-			a.paletteCtrl.MoveCursorUp()
-			a.updatePaletteList()
-		}
+		a.paletteCtrl.MoveCursorUp()
+		a.updatePaletteList()
 		return nil
 	case tcell.KeyDown:
-		if !a.paletteCtrl.IsSearchMode() {
-			a.paletteCtrl.MoveCursorDown()
-			a.updatePaletteList()
+		a.paletteCtrl.MoveCursorDown()
+		a.updatePaletteList()
+		return nil
+	case tcell.KeyTab:
+		// Tab completes the selected query suggestion in search mode
+		if a.paletteCtrl.IsSearchMode() {
+			a.acceptSearchSuggestion()
 		}
 		return nil
 	case tcell.KeyBackspace, tcell.KeyBackspace2:
 		query := a.paletteCtrl.Query()
 		if len(query) > 0 {
-			a.paletteCtrl.SetQuery(query[:len(query)-1])
+			_, size := utf8.DecodeLastRuneInString(query)
+			a.paletteCtrl.SetQuery(query[:len(query)-size])
 			a.paletteInput.SetText(a.paletteCtrl.Query())
-			if !a.paletteCtrl.IsSearchMode() {
-				a.updatePaletteList()
+			if a.paletteCtrl.IsSearchMode() {
+				a.updateSearchSuggestions()
 			}
+			a.updatePaletteList()
 		}
 		return nil
 	case tcell.KeyRune:
 		query := a.paletteCtrl.Query() + string(event.Rune())
 		a.paletteCtrl.SetQuery(query)
 		a.paletteInput.SetText(query)
-		if !a.paletteCtrl.IsSearchMode() {
-			a.updatePaletteList()
+		if a.paletteCtrl.IsSearchMode() {
+			a.updateSearchSuggestions()
 		}
+		a.updatePaletteList()
 		return nil
 	}
 	return event
@@ -930,7 +1148,7 @@ func (a *App) cyclePanesForward() {
 		}
 	case FocusIssues:
 		// If both My and Other issues exist, switch between them
-		if len(a.myIssueRows) > 0 && len(a.otherIssueRows) > 0 {
+		if !a.isBoardLayout() && len(a.myIssueRows) > 0 && len(a.otherIssueRows) > 0 {
 			if a.activeIssuesSection == IssuesSectionMy {
 				// Switch from My Issues to Other Issues
 				a.activeIssuesSection = IssuesSectionOther
@@ -961,7 +1179,7 @@ func (a *App) cyclePanesBackward() {
 		a.focusedDetailsView = false // Start with description
 	case FocusIssues:
 		// If both My and Other issues exist, switch between them
-		if len(a.myIssueRows) > 0 && len(a.otherIssueRows) > 0 {
+		if !a.isBoardLayout() && len(a.myIssueRows) > 0 && len(a.otherIssueRows) > 0 {
 			if a.activeIssuesSection == IssuesSectionOther {
 				// Switch from Other Issues to My Issues
 				a.activeIssuesSection = IssuesSectionMy
@@ -994,13 +1212,17 @@ func (a *App) updateFocus() {
 		a.navigationTree.SetBorderColor(a.theme.BorderFocus)
 		a.myIssuesTable.SetBorderColor(a.theme.Border)
 		a.otherIssuesTable.SetBorderColor(a.theme.Border)
+		a.issuesBoard.SetBorderColor(a.theme.Border)
 		a.detailsDescriptionView.SetBorderColor(a.theme.Border)
 		a.detailsCommentsView.SetBorderColor(a.theme.Border)
 		// Update all pane titles
 		a.updateAllPaneTitles()
 	case FocusIssues:
 		// Focus the active issues section
-		if a.activeIssuesSection == IssuesSectionMy && len(a.myIssueRows) > 0 {
+		if a.isBoardLayout() {
+			a.app.SetFocus(a.issuesBoard)
+			a.issuesBoard.SetBorderColor(a.theme.BorderFocus)
+		} else if a.activeIssuesSection == IssuesSectionMy && len(a.myIssueRows) > 0 {
 			a.app.SetFocus(a.myIssuesTable)
 			a.myIssuesTable.SetBorderColor(a.theme.BorderFocus)
 			a.otherIssuesTable.SetBorderColor(a.theme.Border)
@@ -1022,6 +1244,7 @@ func (a *App) updateFocus() {
 		}
 		if a.focusedDetailsView && a.detailsCommentsVisible {
 			a.app.SetFocus(a.detailsCommentsView)
+			a.ensureCommentFocus()
 			a.detailsDescriptionView.SetBorderColor(a.theme.Border)
 			a.detailsCommentsView.SetBorderColor(a.theme.BorderFocus)
 		} else {
@@ -1032,6 +1255,7 @@ func (a *App) updateFocus() {
 		a.navigationTree.SetBorderColor(a.theme.Border)
 		a.myIssuesTable.SetBorderColor(a.theme.Border)
 		a.otherIssuesTable.SetBorderColor(a.theme.Border)
+		a.issuesBoard.SetBorderColor(a.theme.Border)
 		// Update all pane titles
 		a.updateAllPaneTitles()
 	case FocusPalette:
@@ -1039,6 +1263,7 @@ func (a *App) updateFocus() {
 		a.navigationTree.SetBorderColor(a.theme.Border)
 		a.myIssuesTable.SetBorderColor(a.theme.Border)
 		a.otherIssuesTable.SetBorderColor(a.theme.Border)
+		a.issuesBoard.SetBorderColor(a.theme.Border)
 		a.detailsDescriptionView.SetBorderColor(a.theme.Border)
 		a.detailsCommentsView.SetBorderColor(a.theme.Border)
 		// Update all pane titles
@@ -1095,6 +1320,15 @@ func (a *App) updateAllPaneTitles() {
 		a.otherIssuesTable.SetTitleColor(a.theme.Foreground)
 	}
 
+	// Update Board title
+	if isIssuesFocused {
+		a.issuesBoard.SetTitle(" ▶ Board ")
+		a.issuesBoard.SetTitleColor(a.theme.Accent)
+	} else {
+		a.issuesBoard.SetTitle(" Board ")
+		a.issuesBoard.SetTitleColor(a.theme.Foreground)
+	}
+
 	// Update Details pane titles
 	isDetailsFocused := a.focusedPane == FocusDetails
 	if a.detailsDescriptionView != nil {
@@ -1145,7 +1379,8 @@ func (a *App) openSearchPalette() {
 	a.paletteCtrl.SetQuery(a.searchQuery)
 	a.paletteInput.SetText(a.searchQuery)
 	a.paletteInput.SetLabel("/ ")
-	a.paletteList.Clear()
+	a.updateSearchSuggestions()
+	a.updatePaletteList()
 	a.pages.ShowPage("palette")
 	a.pages.SendToFront("palette")
 	a.focusedPane = FocusPalette
@@ -1229,9 +1464,9 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 
 		params := linearapi.FetchIssuesParams{
 			First:   a.config.PageSize,
-			Search:  a.searchQuery,
 			OrderBy: string(a.sortField),
 		}
+		params.Search, params.Query = searchParams(a.searchQuery, time.Now())
 
 		// Apply team/project/state filter based on navigation selection
 		if a.selectedNavigation != nil {
@@ -1239,23 +1474,34 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 			case a.selectedNavigation.IsStatus:
 				params.TeamID = a.selectedNavigation.TeamID
 				params.StateID = a.selectedNavigation.StateID
+			case a.selectedNavigation.IsCycle:
+				params.TeamID = a.selectedNavigation.TeamID
+				params.CycleID = a.selectedNavigation.CycleID
 			case a.selectedNavigation.IsTeam:
 				params.TeamID = a.selectedNavigation.TeamID
 			case a.selectedNavigation.IsProject:
 				params.TeamID = a.selectedNavigation.TeamID
 				params.ProjectID = a.selectedNavigation.ID
+			case a.selectedNavigation.IsView:
+				params.TeamID = a.selectedNavigation.TeamID
+				params.ProjectID = a.selectedNavigation.ProjectID
+				params.StateID = a.selectedNavigation.StateID
+				params.CycleID = a.selectedNavigation.CycleID
 			}
 			// If "All Issues", no team/project filter
 		}
 
-		fetchPage := a.fetchIssuesPage
-		if fetchPage == nil {
-			fetchPage = a.api.FetchIssuesPage
+		services := a.services()
+		fetchPage := services.fetchIssuesPage
+
+		if a.syncIssuesFromSnapshot(ctx, services, params, generation, targetIssueID, allowFocus) {
+			return
 		}
 
+		syncStart := time.Now()
 		pageCount := 0
 		fetchedCount := 0
-		logger.Debug("tui.app: refreshing issues team_id=%s project_id=%s state_id=%s search=%s", params.TeamID, params.ProjectID, params.StateID, params.Search)
+		logger.Debug("tui.app: refreshing issues team_id=%s project_id=%s state_id=%s cycle_id=%s search=%s", params.TeamID, params.ProjectID, params.StateID, params.CycleID, params.Search)
 		page, err := fetchPage(ctx, params, nil)
 		if err != nil {
 			a.QueueUpdateDraw(func() {
@@ -1276,6 +1522,7 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 
 		pageCount++
 		fetchedCount += len(page.Issues)
+		fetched := append([]linearapi.Issue(nil), page.Issues...)
 		a.QueueUpdateDraw(func() {
 			logger.Debug("tui.app: fetched issues page=%d count=%d", pageCount, len(page.Issues))
 			a.updateIssuesData(page.Issues, targetIssueID)
@@ -1310,6 +1557,7 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 			after = page.EndCursor
 			pageCount++
 			fetchedCount += len(page.Issues)
+			fetched = append(fetched, page.Issues...)
 			a.QueueUpdateDraw(func() {
 				a.appendIssuesData(page.Issues)
 				if page.HasNext {
@@ -1318,6 +1566,11 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 			})
 		}
 
+		// Only a complete fetch is a valid snapshot; an interrupted one would drop issues
+		if !page.HasNext {
+			a.storeFullSync(services, params, fetched, syncStart)
+		}
+
 		a.QueueUpdateDraw(func() {
 			a.isLoading = false
 			logger.Debug("tui.app: refresh completed pages=%d total_fetched=%d", pageCount, fetchedCount)
@@ -1336,6 +1589,13 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 func (a *App) updateIssuesColumnLayout() {
 	a.issuesColumn.Clear()
 
+	// The board replaces both tables
+	if a.isBoardLayout() {
+		a.issuesColumn.AddItem(a.issuesBoard, 0, 1, false)
+		a.updateAllPaneTitles()
+		return
+	}
+
 	// Add My Issues table if there are any
 	if len(a.myIssueRows) > 0 {
 		a.issuesColumn.AddItem(a.myIssuesTable, 0, 1, false)
@@ -1351,6 +1611,9 @@ func (a *App) updateIssuesColumnLayout() {
 // updateIssuesData updates the UI with new issues data.
 // If issueID is provided, that issue will be selected if found in the list.
 func (a *App) updateIssuesData(issues []linearapi.Issue, issueID ...string) {
+	issues = a.withPendingMutations(issues)
+	a.markIssueChanges(issues, true)
+
 	a.issuesMu.Lock()
 	a.issues = issues
 	if a.sortField == SortByPriority {
@@ -1409,6 +1672,9 @@ func (a *App) rebuildIssuesTables(targetIssueID string) *linearapi.Issue {
 
 	// Update layout to show/hide My Issues section.
 	a.updateIssuesColumnLayout()
+	if a.isBoardLayout() {
+		return a.rebuildIssuesBoard(targetIssueID)
+	}
 
 	// Render both tables.
 	var selectedMyIssueID, selectedOtherIssueID string
@@ -1423,8 +1689,8 @@ func (a *App) rebuildIssuesTables(targetIssueID string) *linearapi.Issue {
 		}
 	}
 
-	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
+	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
 
 	// Select issue and update details.
 	var selectedIssue *linearapi.Issue
@@ -1459,6 +1725,8 @@ func (a *App) appendIssuesData(newIssues []linearapi.Issue) {
 	if len(newIssues) == 0 {
 		return
 	}
+	newIssues = a.withPendingMutations(newIssues)
+	a.markIssueChanges(newIssues, false)
 
 	a.issuesMu.Lock()
 	existing := make(map[string]bool, len(a.issues))
@@ -1526,11 +1794,7 @@ func (a *App) onIssueSelected(issue linearapi.Issue) {
 	go func() {
 		logger.Debug("tui.app: fetching full issue details issue=%s", issue.Identifier)
 		ctx := context.Background()
-		fetchIssue := a.fetchIssueByID
-		if fetchIssue == nil {
-			fetchIssue = a.api.FetchIssueByID
-		}
-		fullIssue, err := fetchIssue(ctx, issueID)
+		fullIssue, err := a.services().fetchIssueByID(ctx, issueID)
 
 		a.QueueUpdateDraw(func() {
 			// Race-safety: only apply if this is still the issue we're fetching
@@ -1540,6 +1804,7 @@ func (a *App) onIssueSelected(issue linearapi.Issue) {
 					// Keep the partial issue data we already have
 					return
 				}
+				fullIssue = a.withPendingMutations([]linearapi.Issue{fullIssue})[0]
 				a.issuesMu.Lock()
 				a.selectedIssue = &fullIssue
 				a.issuesMu.Unlock()
@@ -1612,26 +1877,45 @@ func (a *App) toggleIssueExpanded(issueID string) {
 		a.activeIssuesSection = IssuesSectionOther
 	}
 
-	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
+	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
 }
 
 // onNavigationSelected handles when a navigation item is selected.
 func (a *App) onNavigationSelected(node *NavigationNode) {
 	logger.Debug("tui.app: navigation selected node_id=%s node_text=%s is_team=%v is_project=%v", node.ID, node.Text, node.IsTeam, node.IsProject)
+	if node.IsInbox {
+		// The inbox opens over the current issues instead of replacing them
+		a.ShowInbox()
+		return
+	}
+	previous := a.selectedNavigation
 	a.selectedNavigation = node
 
+	if node.IsView {
+		// Apply the view's filter, sort, and grouping
+		if view, ok := config.FindSavedView(a.savedViews, node.ViewName); ok {
+			a.applySavedView(view)
+		}
+	} else if previous != nil && previous.IsView {
+		// Leaving a view drops its filter unless the search was changed since
+		if view, ok := config.FindSavedView(a.savedViews, previous.ViewName); ok && a.searchQuery == view.Query {
+			a.searchQuery = ""
+		}
+	}
+
 	// Update selected team/project
-	if node.IsTeam {
+	if node.IsTeam || (node.IsView && node.TeamID != "") {
 		// Load team metadata (users, workflow states) in background
 		go func() {
 			logger.Debug("tui.app: preloading team metadata team_id=%s", node.TeamID)
 			ctx := context.Background()
-			_ = a.cache.PreloadTeamMetadata(ctx, node.TeamID)
+			teamCache := a.GetCache()
+			_ = teamCache.PreloadTeamMetadata(ctx, node.TeamID)
 
 			// Update team users and states for the selected team
-			users, _ := a.cache.GetUsers(ctx, node.TeamID)
-			states, _ := a.cache.GetWorkflowStates(ctx, node.TeamID)
+			users, _ := teamCache.GetUsers(ctx, node.TeamID)
+			states, _ := teamCache.GetWorkflowStates(ctx, node.TeamID)
 
 			logger.Debug("tui.app: loaded team metadata team_id=%s users_count=%d states_count=%d", node.TeamID, len(users), len(states))
 			a.app.QueueUpdateDraw(func() {
@@ -1676,8 +1960,14 @@ func (a *App) updateStatusBar() {
 		helpText = fmt.Sprintf("%s↑↓: navigate | Enter: select | Tab/→/l: next pane | Shift+Tab/←/h: prev pane | :: palette | /: search | q: quit[-]", keyColor)
 	case FocusIssues:
 		helpText = fmt.Sprintf("%sj/k: navigate | Enter: select | Tab/→/l: next pane | Shift+Tab/←/h: prev pane | :: palette | /: search | q: quit[-]", keyColor)
+		if a.isBoardLayout() {
+			helpText = fmt.Sprintf("%sh/l: column | j/k: card | H/L: move card | Enter: select | Tab: next pane | :: palette | /: search | q: quit[-]", keyColor)
+		}
 	case FocusDetails:
 		helpText = fmt.Sprintf("%sj/k: scroll | Tab: switch description/comments | →/l: next pane | Shift+Tab/←/h: prev pane | :: palette | /: search | q: quit[-]", keyColor)
+		if a.isCommentsViewFocused() {
+			helpText = fmt.Sprintf("%sj/k: scroll | n/p: next/prev comment | r: reply | e: edit | d: delete | Tab: next pane | :: palette | q: quit[-]", keyColor)
+		}
 	case FocusPalette:
 		helpText = fmt.Sprintf("%s↑↓: navigate | Enter: execute | Esc: close[-]", keyColor)
 	default:
@@ -1720,27 +2010,60 @@ func (a *App) updateStatusBar() {
 		parts = append(parts, searchText)
 	}
 	parts = append(parts, statusText)
+	if marked := len(a.markedIssueIDs); marked > 0 || a.visualAnchorID != "" {
+		selectionText := fmt.Sprintf("%s%s %d selected", a.themeTags.Accent, IconMarked, marked)
+		if a.visualAnchorID != "" {
+			selectionText += " (visual)"
+		}
+		parts = append(parts, selectionText+"[-]")
+	}
+	if a.bulkStatus != "" {
+		parts = append(parts, a.bulkStatus)
+	}
+	if pending := a.pendingMutationCount(); pending > 0 {
+		parts = append(parts, fmt.Sprintf("%s⟳ %d pending[-]", a.themeTags.Warning, pending))
+	}
+	if remaining := time.Until(a.rateLimitedUntil); remaining > 0 {
+		parts = append(parts, fmt.Sprintf("%sRate limited: %s[-]", a.themeTags.Error, formatCountdown(remaining)))
+	}
+	if !a.lastRefreshAt.IsZero() {
+		parts = append(parts, fmt.Sprintf("%s%s[-]", a.themeTags.SecondaryText, formatUpdatedAgo(time.Since(a.lastRefreshAt))))
+	}
 
 	text := parts[0]
 	for i := 1; i < len(parts); i++ {
 		text += sep + parts[i]
 	}
 
+	a.statusBarText = text
 	a.statusBar.SetText(text)
 }
 
 // updateStatusBarWithError updates the status bar with an error message.
 func (a *App) updateStatusBarWithError(err error) {
+	var rateErr *linearapi.RateLimitedError
+	if errors.As(err, &rateErr) {
+		a.showRateLimitCountdown(rateErr)
+		return
+	}
+	if pending := a.pendingMutationCount(); pending > 0 && offline.IsNetworkError(err) {
+		a.statusBar.SetText(fmt.Sprintf("%sOffline: %d changes pending sync[-]", a.themeTags.Warning, pending))
+		return
+	}
 	a.statusBar.SetText(fmt.Sprintf("%sError: %v[-]", a.themeTags.Error, err))
 }
 
 // GetAPI returns the Linear API client (used by commands).
 func (a *App) GetAPI() *linearapi.Client {
+	a.servicesMu.RLock()
+	defer a.servicesMu.RUnlock()
 	return a.api
 }
 
 // GetCache returns the team cache (used by commands).
 func (a *App) GetCache() *cache.TeamCache {
+	a.servicesMu.RLock()
+	defer a.servicesMu.RUnlock()
 	return a.cache
 }
 
@@ -1779,7 +2102,7 @@ func (a *App) GetTeamUsers() []linearapi.User {
 // FetchTeamUsers fetches users for a specific team from the API.
 func (a *App) FetchTeamUsers(teamID string) ([]linearapi.User, error) {
 	ctx := context.Background()
-	users, err := a.cache.GetUsers(ctx, teamID)
+	users, err := a.GetCache().GetUsers(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
@@ -1840,7 +2163,7 @@ func (a *App) ShowStatusPicker(onSelect func(stateID string)) {
 			"workflow states",
 			func() bool { return len(a.workflowStates) > 0 },
 			func(ctx context.Context, teamID string) error {
-				loadedStates, err := a.cache.GetWorkflowStates(ctx, teamID)
+				loadedStates, err := a.GetCache().GetWorkflowStates(ctx, teamID)
 				if err != nil {
 					return err
 				}
@@ -1872,6 +2195,51 @@ func (a *App) showStatusPickerWithStates(states []linearapi.WorkflowState, onSel
 	})
 }
 
+// ShowCyclePicker shows a picker for a team's active and upcoming cycles.
+// The picker includes a "No cycle" entry that selects an empty cycle ID.
+func (a *App) ShowCyclePicker(teamID string, onSelect func(cycleID string)) {
+	logger.Debug("tui.app: showing cycle picker team_id=%s", teamID)
+	if teamID == "" {
+		teamID = a.GetSelectedTeamID()
+	}
+	if teamID == "" {
+		logger.Warning("tui.app: cannot show cycle picker, no team selected")
+		return
+	}
+	go func() {
+		cycles, err := a.GetCache().GetCycles(context.Background(), teamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.app: failed to load cycles team_id=%s", teamID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			a.showCyclePickerWithCycles(cycles, onSelect)
+		})
+	}()
+}
+
+func (a *App) showCyclePickerWithCycles(cycles []linearapi.Cycle, onSelect func(cycleID string)) {
+	items := make([]PickerItem, 0, len(cycles)+1)
+	items = append(items, PickerItem{ID: "", Label: "No cycle"})
+	for _, cycle := range cycles {
+		label := cycleLabel(cycle.Number, cycle.Name)
+		if cycle.IsActive {
+			label += " (current)"
+		}
+		items = append(items, PickerItem{
+			ID:    cycle.ID,
+			Label: label,
+		})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Select Cycle", items, func(item PickerItem) {
+		a.pickerActive = false
+		onSelect(item.ID)
+	})
+}
+
 // ShowUserPicker shows a picker for team users.
 func (a *App) ShowUserPicker(onSelect func(userID string)) {
 	logger.Debug("tui.app: showing user picker")
@@ -1881,7 +2249,7 @@ func (a *App) ShowUserPicker(onSelect func(userID string)) {
 			"users for picker",
 			func() bool { return len(a.teamUsers) > 0 },
 			func(ctx context.Context, teamID string) error {
-				loadedUsers, err := a.cache.GetUsers(ctx, teamID)
+				loadedUsers, err := a.GetCache().GetUsers(ctx, teamID)
 				if err != nil {
 					return err
 				}
@@ -1948,6 +2316,75 @@ func (a *App) ShowParentIssuePicker(onSelect func(parentID string)) {
 	})
 }
 
+// ShowRelationTypePicker shows a picker for choosing how to relate two issues.
+func (a *App) ShowRelationTypePicker(onSelect func(relType linearapi.RelationType)) {
+	items := make([]PickerItem, 0, len(relationTypeOrder))
+	for _, relType := range relationTypeOrder {
+		items = append(items, PickerItem{
+			ID:    string(relType),
+			Label: relationTypeLabel(relType),
+		})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Select Relation", items, func(item PickerItem) {
+		a.pickerActive = false
+		onSelect(linearapi.RelationType(item.ID))
+	})
+}
+
+// ShowRelatedIssuePicker shows a picker for selecting an issue to relate to.
+// It lists all issues from the current list except the issue being related.
+func (a *App) ShowRelatedIssuePicker(excludeID string, onSelect func(issueID string)) {
+	a.issuesMu.RLock()
+	issues := a.issues
+	a.issuesMu.RUnlock()
+	items := make([]PickerItem, 0, len(issues))
+	for _, issue := range issues {
+		if issue.ID == excludeID {
+			continue
+		}
+		items = append(items, PickerItem{
+			ID:    issue.ID,
+			Label: issue.Identifier + " - " + issue.Title,
+		})
+	}
+
+	if len(items) == 0 {
+		logger.Warning("tui.app: no issues available for relation picker")
+		a.updateStatusBarWithError(fmt.Errorf("no issues available to relate"))
+		return
+	}
+	logger.Debug("tui.app: related issue picker items count=%d", len(items))
+
+	a.pickerActive = true
+	a.pickerModal.Show("Select Related Issue", items, func(item PickerItem) {
+		a.pickerActive = false
+		onSelect(item.ID)
+	})
+}
+
+// ShowIssueRelationPicker shows a picker for one of the issue's existing relations.
+func (a *App) ShowIssueRelationPicker(issue *linearapi.Issue, onSelect func(relationID string)) {
+	if len(issue.Relations) == 0 {
+		a.updateStatusBarWithError(fmt.Errorf("%s has no relations", issue.Identifier))
+		return
+	}
+	items := make([]PickerItem, 0, len(issue.Relations))
+	for _, relation := range issue.Relations {
+		items = append(items, PickerItem{
+			ID:    relation.ID,
+			Label: relationTypeLabel(relation.Type) + " " + relation.Issue.Identifier + " - " + relation.Issue.Title,
+		})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Remove Relation", items, func(item PickerItem) {
+		a.pickerActive = false
+		onSelect(item.ID)
+	})
+}
+
 // ShowCreateIssueModal shows the create issue modal.
 func (a *App) ShowCreateIssueModal() {
 	a.showCreateIssueModalWithParent("")
@@ -1964,6 +2401,8 @@ func (a *App) showCreateIssueModalWithParent(parentID string) {
 	projectID := ""
 	if a.selectedNavigation != nil && a.selectedNavigation.IsProject {
 		projectID = a.selectedNavigation.ID
+	} else if a.selectedNavigation != nil && a.selectedNavigation.IsView {
+		projectID = a.selectedNavigation.ProjectID
 	}
 
 	a.createIssueModal.Show(teamID, projectID, func(title, description, tID, pID, assigneeID string, priority int) {
@@ -1989,7 +2428,7 @@ func (a *App) showCreateIssueModalWithParent(parentID string) {
 			if parentID != "" {
 				input.ParentID = parentID
 			}
-			issue, err := a.api.CreateIssue(ctx, input)
+			issue, err := a.GetAPI().CreateIssue(ctx, input)
 			a.QueueUpdateDraw(func() {
 				if err != nil {
 					logger.ErrorWithErr(err, "tui.app: failed to create issue title=%s", title)
@@ -2017,7 +2456,7 @@ func (a *App) ShowEditTitleModal() {
 	a.editTitleModal.Show(issue.ID, issue.Title, func(issueID, title string) {
 		go func() {
 			ctx := context.Background()
-			_, err := a.api.UpdateIssue(ctx, linearapi.UpdateIssueInput{
+			_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 				ID:    issueID,
 				Title: &title,
 			})
@@ -2061,7 +2500,7 @@ func (a *App) ShowEditLabelsModal() {
 	go func() {
 		logger.Debug("tui.app: loading labels for edit modal issue=%s team_id=%s", issue.Identifier, teamID)
 		ctx := context.Background()
-		availableLabels, err := a.cache.GetIssueLabels(ctx, teamID)
+		availableLabels, err := a.GetCache().GetIssueLabels(ctx, teamID)
 		if err != nil {
 			logger.ErrorWithErr(err, "tui.app: failed to load labels issue=%s team_id=%s", issue.Identifier, teamID)
 			a.QueueUpdateDraw(func() {
@@ -2075,7 +2514,7 @@ func (a *App) ShowEditLabelsModal() {
 			a.editLabelsModal.Show(issue.ID, currentLabelIDs, availableLabels, func(issueID string, labelIDs []string) {
 				go func() {
 					ctx := context.Background()
-					_, err := a.api.UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 						ID:       issueID,
 						LabelIDs: &labelIDs,
 					})
@@ -2,10 +2,14 @@ package tui
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/roeyazroel/linear-tui/internal/cache"
 	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 )
@@ -28,6 +32,29 @@ func waitForCondition(t *testing.T, timeout time.Duration, check func() bool) {
 	t.Fatalf("condition not met within %s", timeout)
 }
 
+// serializeUpdates runs queued UI updates inline, one at a time, and returns
+// the lock they hold so tests can inspect UI state between updates.
+func serializeUpdates(app *App) *sync.Mutex {
+	var mu sync.Mutex
+	app.queueUpdateDraw = func(f func()) {
+		mu.Lock()
+		defer mu.Unlock()
+		f()
+	}
+	return &mu
+}
+
+// waitForRefresh waits until the running issues refresh has rendered, so its
+// goroutine does not outlive the test.
+func waitForRefresh(t *testing.T, app *App, ui *sync.Mutex) {
+	t.Helper()
+	waitForCondition(t, time.Second, func() bool {
+		ui.Lock()
+		defer ui.Unlock()
+		return !app.isLoading
+	})
+}
+
 // TestRefreshIssues_LazyLoadsPages verifies first page renders before background pages.
 func TestRefreshIssues_LazyLoadsPages(t *testing.T) {
 	cfg := config.Config{
@@ -205,7 +232,7 @@ func TestRefreshIssues_IncludesStateID(t *testing.T) {
 		CacheTTL: time.Minute,
 	}
 	app := NewApp(&linearapi.Client{}, cfg, nil)
-	app.queueUpdateDraw = func(f func()) { f() }
+	ui := serializeUpdates(app)
 
 	called := make(chan linearapi.FetchIssuesParams, 1)
 	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
@@ -238,4 +265,124 @@ func TestRefreshIssues_IncludesStateID(t *testing.T) {
 	case <-time.After(time.Second):
 		t.Fatal("timed out waiting for fetchIssuesPage")
 	}
+
+	waitForRefresh(t, app, ui)
+}
+
+func TestRefreshIssues_IncludesCycleID(t *testing.T) {
+	cfg := config.Config{
+		PageSize: 1,
+		CacheTTL: time.Minute,
+	}
+	app := NewApp(&linearapi.Client{}, cfg, nil)
+	ui := serializeUpdates(app)
+
+	called := make(chan linearapi.FetchIssuesParams, 1)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		select {
+		case called <- params:
+		default:
+		}
+		return linearapi.IssuePage{Issues: []linearapi.Issue{}, HasNext: false}, nil
+	}
+
+	app.selectedNavigation = &NavigationNode{
+		ID:      "cycle-12",
+		Text:    "Cycle 12",
+		TeamID:  "team-1",
+		IsCycle: true,
+		CycleID: "cycle-12",
+	}
+
+	app.refreshIssues()
+
+	select {
+	case params := <-called:
+		if params.CycleID != "cycle-12" {
+			t.Fatalf("CycleID = %q, want %q", params.CycleID, "cycle-12")
+		}
+		if params.TeamID != "team-1" {
+			t.Fatalf("TeamID = %q, want %q", params.TeamID, "team-1")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for fetchIssuesPage")
+	}
+
+	waitForRefresh(t, app, ui)
+}
+
+func TestCycleLabel(t *testing.T) {
+	if got := cycleLabel(12, ""); got != "Cycle 12" {
+		t.Errorf("cycleLabel(12, \"\") = %q, want %q", got, "Cycle 12")
+	}
+	if got := cycleLabel(3, "Launch"); got != "Cycle 3: Launch" {
+		t.Errorf("cycleLabel(3, \"Launch\") = %q, want %q", got, "Cycle 3: Launch")
+	}
+}
+
+func TestRefreshIssues_DeltaSyncsFromSnapshot(t *testing.T) {
+	cfg := config.Config{
+		PageSize: 10,
+		CacheTTL: time.Minute,
+	}
+	app := NewApp(&linearapi.Client{}, cfg, nil)
+	ui := serializeUpdates(app)
+
+	cachePath := filepath.Join(t.TempDir(), "cache.json")
+	store, err := cache.OpenDiskStore(cachePath, "owner")
+	if err != nil {
+		t.Fatalf("OpenDiskStore() error = %v", err)
+	}
+	watermark := time.Now().Add(-time.Hour)
+	params := linearapi.FetchIssuesParams{TeamID: "team-1"}
+	store.PutSnapshot(cache.IssueScopeKey(params), cache.IssueSnapshot{
+		Issues:     []linearapi.Issue{{ID: "cached", Identifier: "ENG-1", TeamID: "team-1", UpdatedAt: watermark}},
+		Watermark:  watermark,
+		FullSyncAt: time.Now(),
+	})
+	app.SetIssueStore(store)
+	app.selectedNavigation = &NavigationNode{ID: "team-1", TeamID: "team-1", IsTeam: true}
+	// The first synced issue is selected, which loads its details
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		return linearapi.Issue{ID: id, TeamID: "team-1", Title: "Loaded"}, nil
+	}
+
+	called := make(chan linearapi.FetchIssuesParams, 1)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		select {
+		case called <- params:
+		default:
+		}
+		return linearapi.IssuePage{Issues: []linearapi.Issue{
+			{ID: "changed", Identifier: "ENG-2", TeamID: "team-1", UpdatedAt: watermark.Add(time.Minute)},
+		}}, nil
+	}
+
+	app.refreshIssues()
+
+	select {
+	case params := <-called:
+		if !params.UpdatedSince.Equal(watermark) {
+			t.Fatalf("UpdatedSince = %s, want watermark %s", params.UpdatedSince, watermark)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for delta fetch")
+	}
+
+	waitForCondition(t, time.Second, func() bool {
+		snapshot, _ := store.Snapshot(cache.IssueScopeKey(params))
+		return len(snapshot.Issues) == 2
+	})
+	// The merged snapshot is written in the background; let it finish before
+	// the temp directory is removed
+	waitForCondition(t, time.Second, func() bool {
+		_, err := os.Stat(cachePath)
+		return err == nil
+	})
+	waitForCondition(t, time.Second, func() bool {
+		ui.Lock()
+		defer ui.Unlock()
+		selected := app.GetSelectedIssue()
+		return selected != nil && selected.Title == "Loaded"
+	})
 }
@@ -0,0 +1,301 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// maxListedBulkFailures limits how many failures are spelled out in the status bar.
+const maxListedBulkFailures = 3
+
+// bulkFailure records an issue a bulk operation could not update.
+type bulkFailure struct {
+	IssueID    string
+	Identifier string
+	Err        error
+}
+
+// runBulk applies an operation to each issue in turn, showing progress in the
+// status bar and a per-issue failure summary when done. Issues that failed stay
+// marked so the operation can be retried on them.
+func (a *App) runBulk(action string, issues []linearapi.Issue, apply func(ctx context.Context, issue linearapi.Issue) error) {
+	total := len(issues)
+	logger.Info("tui.commands: starting bulk operation action=%s count=%d", action, total)
+	a.bulkStatus = fmt.Sprintf("%s⟳ %s 0/%d[-]", a.themeTags.Warning, action, total)
+	a.updateStatusBar()
+
+	go func() {
+		ctx := context.Background()
+		var failures []bulkFailure
+		for i, issue := range issues {
+			a.QueueUpdateDraw(func() {
+				a.bulkStatus = fmt.Sprintf("%s⟳ %s %d/%d[-]", a.themeTags.Warning, action, i+1, total)
+				a.updateStatusBar()
+			})
+			if err := apply(ctx, issue); err != nil {
+				logger.ErrorWithErr(err, "tui.commands: bulk %s failed issue=%s", action, issue.Identifier)
+				failures = append(failures, bulkFailure{IssueID: issue.ID, Identifier: issue.Identifier, Err: err})
+			}
+		}
+		logger.Info("tui.commands: finished bulk operation action=%s count=%d failed=%d", action, total, len(failures))
+
+		a.QueueUpdateDraw(func() {
+			a.visualAnchorID = ""
+			a.visualBaseMarked = nil
+			a.markedIssueIDs = make(map[string]bool, len(failures))
+			for _, failure := range failures {
+				a.markedIssueIDs[failure.IssueID] = true
+			}
+			color := a.themeTags.Accent
+			if len(failures) > 0 {
+				color = a.themeTags.Error
+			}
+			a.bulkStatus = color + bulkSummary(action, total, failures) + "[-]"
+			a.renderIssueMarks()
+			go a.refreshIssues()
+		})
+	}()
+}
+
+// bulkSummary describes the outcome of a bulk operation.
+func bulkSummary(action string, total int, failures []bulkFailure) string {
+	summary := fmt.Sprintf("%s: %d/%d updated", action, total-len(failures), total)
+	if len(failures) == 0 {
+		return summary
+	}
+
+	listed := make([]string, 0, maxListedBulkFailures)
+	for i, failure := range failures {
+		if i == maxListedBulkFailures {
+			listed = append(listed, fmt.Sprintf("%d more", len(failures)-maxListedBulkFailures))
+			break
+		}
+		listed = append(listed, fmt.Sprintf("%s: %v", failure.Identifier, failure.Err))
+	}
+	return fmt.Sprintf("%s, %d failed (%s)", summary, len(failures), strings.Join(listed, "; "))
+}
+
+// bulkTeamID returns the team shared by all issues. Team-scoped values such as
+// workflow states and labels can only be applied in bulk within one team.
+func bulkTeamID(issues []linearapi.Issue) (string, error) {
+	teamID := ""
+	for _, issue := range issues {
+		if teamID == "" {
+			teamID = issue.TeamID
+			continue
+		}
+		if issue.TeamID != "" && issue.TeamID != teamID {
+			return "", fmt.Errorf("selected issues belong to different teams")
+		}
+	}
+	return teamID, nil
+}
+
+// commonLabelIDs returns the IDs of labels present on every issue.
+func commonLabelIDs(issues []linearapi.Issue) []string {
+	if len(issues) == 0 {
+		return nil
+	}
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		seen := make(map[string]bool, len(issue.Labels))
+		for _, label := range issue.Labels {
+			if !seen[label.ID] {
+				seen[label.ID] = true
+				counts[label.ID]++
+			}
+		}
+	}
+	common := make([]string, 0, len(counts))
+	for _, label := range issues[0].Labels {
+		if counts[label.ID] == len(issues) {
+			common = append(common, label.ID)
+		}
+	}
+	return common
+}
+
+// applyLabelChanges returns an issue's label IDs after adding and removing labels.
+// Labels the bulk edit did not touch are kept.
+func applyLabelChanges(labels []linearapi.IssueLabel, before, after []string) []string {
+	removed := make(map[string]bool, len(before))
+	for _, id := range before {
+		removed[id] = true
+	}
+	for _, id := range after {
+		delete(removed, id)
+	}
+
+	result := make([]string, 0, len(labels)+len(after))
+	seen := make(map[string]bool, len(labels)+len(after))
+	for _, label := range labels {
+		if !removed[label.ID] && !seen[label.ID] {
+			seen[label.ID] = true
+			result = append(result, label.ID)
+		}
+	}
+	for _, id := range after {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// bulkUpdate updates every issue with the same input fields.
+func (a *App) bulkUpdate(action string, issues []linearapi.Issue, input func(issue linearapi.Issue) linearapi.UpdateIssueInput) {
+	a.runBulk(action, issues, func(ctx context.Context, issue linearapi.Issue) error {
+		_, err := a.updateIssue(ctx, input(issue))
+		return err
+	})
+}
+
+// bulkChangeStatus changes the status of all marked issues.
+func (a *App) bulkChangeStatus(issues []linearapi.Issue) {
+	if _, err := bulkTeamID(issues); err != nil {
+		a.updateStatusBarWithError(err)
+		return
+	}
+	a.ShowStatusPicker(func(stateID string) {
+		a.bulkUpdate("Change status", issues, func(issue linearapi.Issue) linearapi.UpdateIssueInput {
+			return linearapi.UpdateIssueInput{ID: issue.ID, StateID: &stateID}
+		})
+	})
+}
+
+// bulkAssign assigns all marked issues to a user ("" unassigns).
+func (a *App) bulkAssign(issues []linearapi.Issue, userID string) {
+	action := "Assign"
+	if userID == "" {
+		action = "Unassign"
+	}
+	a.bulkUpdate(action, issues, func(issue linearapi.Issue) linearapi.UpdateIssueInput {
+		return linearapi.UpdateIssueInput{ID: issue.ID, AssigneeID: &userID}
+	})
+}
+
+// bulkSetPriority sets the priority of all marked issues.
+func (a *App) bulkSetPriority(issues []linearapi.Issue, priority int) {
+	a.bulkUpdate("Set priority", issues, func(issue linearapi.Issue) linearapi.UpdateIssueInput {
+		return linearapi.UpdateIssueInput{ID: issue.ID, Priority: &priority}
+	})
+}
+
+// setPriority sets the priority of the marked issues, or of the selected issue
+// when none are marked.
+func (a *App) setPriority(priority int) {
+	if marked := a.markedIssues(); len(marked) > 0 {
+		a.bulkSetPriority(marked, priority)
+		return
+	}
+	issue := a.GetSelectedIssue()
+	if issue == nil || issue.Priority == priority {
+		return
+	}
+	go func() {
+		ctx := context.Background()
+		_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
+			ID:       issue.ID,
+			Priority: &priority,
+		})
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.commands: failed to set priority issue=%s", issue.Identifier)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			logger.Info("tui.commands: set priority issue=%s priority=%d", issue.Identifier, priority)
+			go a.refreshIssues(issue.ID)
+		})
+	}()
+}
+
+// bulkSetParent moves all marked issues under a parent issue.
+func (a *App) bulkSetParent(issues []linearapi.Issue) {
+	a.ShowParentIssuePicker(func(parentID string) {
+		a.runBulk("Set parent", issues, func(ctx context.Context, issue linearapi.Issue) error {
+			if issue.ID == parentID {
+				return fmt.Errorf("issue cannot be its own parent")
+			}
+			if len(issue.Children) > 0 {
+				return fmt.Errorf("issue has sub-issues")
+			}
+			_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{ID: issue.ID, ParentID: &parentID})
+			return err
+		})
+	})
+}
+
+// bulkArchive archives all marked issues.
+func (a *App) bulkArchive(issues []linearapi.Issue) {
+	a.runBulk("Archive", issues, func(ctx context.Context, issue linearapi.Issue) error {
+		return a.GetAPI().ArchiveIssue(ctx, issue.ID)
+	})
+}
+
+// bulkEditLabels edits labels on all marked issues. The modal starts with the
+// labels every issue shares; labels checked or unchecked there are added to or
+// removed from each issue, and other labels are left alone.
+func (a *App) bulkEditLabels(issues []linearapi.Issue) {
+	teamID, err := bulkTeamID(issues)
+	if err == nil && teamID == "" {
+		teamID = a.GetSelectedTeamID()
+	}
+	if err == nil && teamID == "" {
+		err = fmt.Errorf("cannot edit labels: no team context")
+	}
+	if err != nil {
+		a.updateStatusBarWithError(err)
+		return
+	}
+
+	before := commonLabelIDs(issues)
+	go func() {
+		availableLabels, err := a.GetCache().GetIssueLabels(context.Background(), teamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.commands: failed to load labels for bulk edit team_id=%s", teamID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			a.editLabelsModal.Show(issues[0].ID, before, availableLabels, func(_ string, after []string) {
+				a.bulkUpdate("Set labels", issues, func(issue linearapi.Issue) linearapi.UpdateIssueInput {
+					labelIDs := applyLabelChanges(issue.Labels, before, after)
+					return linearapi.UpdateIssueInput{ID: issue.ID, LabelIDs: &labelIDs}
+				})
+			})
+		})
+	}()
+}
+
+// ShowPriorityPicker shows a picker for Linear's priority levels. Each level
+// is labeled with its quick-set key in the issues table.
+func (a *App) ShowPriorityPicker(onSelect func(priority int)) {
+	items := make([]PickerItem, 0, 5)
+	for priority := 0; priority <= 4; priority++ {
+		label, _ := formatPriority(priority, a.theme)
+		if priority == 0 {
+			label = "No priority"
+		}
+		items = append(items, PickerItem{
+			ID:    strconv.Itoa(priority),
+			Label: fmt.Sprintf("%s (%d)", label, priority),
+		})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Select Priority", items, func(item PickerItem) {
+		a.pickerActive = false
+		priority, err := strconv.Atoi(item.ID)
+		if err != nil {
+			return
+		}
+		onSelect(priority)
+	})
+}
@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestIssueIDsInRange(t *testing.T) {
+	rows := []IssueRow{{IssueID: "a"}, {IssueID: "b"}, {IssueID: "c"}, {IssueID: "d"}}
+
+	if got := issueIDsInRange(rows, "b", "d"); !reflect.DeepEqual(got, []string{"b", "c", "d"}) {
+		t.Errorf("issueIDsInRange(b, d) = %v", got)
+	}
+	if got := issueIDsInRange(rows, "c", "a"); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("issueIDsInRange(c, a) = %v", got)
+	}
+	if got := issueIDsInRange(rows, "a", "missing"); got != nil {
+		t.Errorf("issueIDsInRange(a, missing) = %v, want nil", got)
+	}
+}
+
+func TestVisualSelectionKeepsEarlierMarks(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 10}, nil)
+	app.queueUpdateDraw = func(f func()) { f() }
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		return linearapi.Issue{ID: id}, nil
+	}
+	app.updateIssuesData([]linearapi.Issue{
+		{ID: "a", Identifier: "ENG-1"},
+		{ID: "b", Identifier: "ENG-2"},
+		{ID: "c", Identifier: "ENG-3"},
+		{ID: "d", Identifier: "ENG-4"},
+	})
+	section := app.activeIssuesSection
+
+	app.toggleIssueMark("d")
+	app.toggleVisualMode("a", section)
+	app.extendVisualSelection("c", section)
+	app.extendVisualSelection("b", section)
+
+	want := map[string]bool{"a": true, "b": true, "d": true}
+	if !reflect.DeepEqual(app.markedIssueIDs, want) {
+		t.Errorf("marked = %v, want %v", app.markedIssueIDs, want)
+	}
+
+	app.toggleVisualMode("b", section)
+	if app.visualAnchorID != "" || len(app.markedIssues()) != 3 {
+		t.Errorf("after leaving visual mode anchor=%q marked=%d, want marks kept", app.visualAnchorID, len(app.markedIssues()))
+	}
+
+	app.markAllInSection(section)
+	if len(app.markedIssueIDs) != 4 {
+		t.Errorf("markAllInSection marked %d issues, want 4", len(app.markedIssueIDs))
+	}
+	app.markAllInSection(section)
+	if len(app.markedIssueIDs) != 0 {
+		t.Errorf("second markAllInSection left %d marks, want 0", len(app.markedIssueIDs))
+	}
+}
+
+func TestBulkSummary(t *testing.T) {
+	if got := bulkSummary("Archive", 3, nil); got != "Archive: 3/3 updated" {
+		t.Errorf("bulkSummary() = %q", got)
+	}
+
+	failures := []bulkFailure{
+		{Identifier: "ENG-1", Err: errors.New("boom")},
+		{Identifier: "ENG-2", Err: errors.New("boom")},
+		{Identifier: "ENG-3", Err: errors.New("boom")},
+		{Identifier: "ENG-4", Err: errors.New("boom")},
+	}
+	got := bulkSummary("Set priority", 10, failures)
+	for _, want := range []string{"6/10 updated", "4 failed", "ENG-1: boom", "1 more"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("bulkSummary() = %q, missing %q", got, want)
+		}
+	}
+	if strings.Contains(got, "ENG-4") {
+		t.Errorf("bulkSummary() = %q, should not list more than %d failures", got, maxListedBulkFailures)
+	}
+}
+
+func TestBulkTeamID(t *testing.T) {
+	if teamID, err := bulkTeamID([]linearapi.Issue{{TeamID: "t1"}, {TeamID: "t1"}}); err != nil || teamID != "t1" {
+		t.Errorf("bulkTeamID() = %q, %v; want t1", teamID, err)
+	}
+	if _, err := bulkTeamID([]linearapi.Issue{{TeamID: "t1"}, {TeamID: "t2"}}); err == nil {
+		t.Error("bulkTeamID() expected error for mixed teams")
+	}
+}
+
+func TestBulkLabelChanges(t *testing.T) {
+	issues := []linearapi.Issue{
+		{ID: "1", Labels: []linearapi.IssueLabel{{ID: "bug"}, {ID: "ui"}}},
+		{ID: "2", Labels: []linearapi.IssueLabel{{ID: "bug"}, {ID: "api"}}},
+	}
+	before := commonLabelIDs(issues)
+	if !reflect.DeepEqual(before, []string{"bug"}) {
+		t.Fatalf("commonLabelIDs() = %v, want [bug]", before)
+	}
+
+	// Uncheck "bug", check "p1": each issue keeps its own other labels
+	after := []string{"p1"}
+	if got := applyLabelChanges(issues[0].Labels, before, after); !reflect.DeepEqual(got, []string{"ui", "p1"}) {
+		t.Errorf("applyLabelChanges(issue 1) = %v, want [ui p1]", got)
+	}
+	if got := applyLabelChanges(issues[1].Labels, before, after); !reflect.DeepEqual(got, []string{"api", "p1"}) {
+		t.Errorf("applyLabelChanges(issue 2) = %v, want [api p1]", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 	"github.com/roeyazroel/linear-tui/internal/logger"
 )
@@ -59,75 +60,15 @@ func handleAskAgent(a *App) {
 		if prompt == "" {
 			return
 		}
-		workspace = strings.TrimSpace(workspace)
 
-		go func() {
-			fetchIssue := a.fetchIssueByID
-			if fetchIssue == nil {
-				fetchIssue = a.api.FetchIssueByID
-			}
-
-			fullIssue, err := fetchIssue(context.Background(), issueID)
-			if err != nil {
-				logger.ErrorWithErr(err, "tui.commands: failed to fetch issue for agent issue_id=%s", issueID)
-				a.QueueUpdateDraw(func() {
-					a.updateStatusBarWithError(err)
-				})
-				return
-			}
-
-			issueContext := agents.BuildIssueContext(fullIssue)
-			runner := a.agentRunner
-
-			selected, err := agents.ProviderForKey(a.config.AgentProvider, runner.LookPath)
-			if err != nil {
-				logger.Error("tui.commands: invalid agent provider provider=%s", a.config.AgentProvider)
-				a.QueueUpdateDraw(func() {
-					a.updateStatusBarWithError(err)
-				})
-				return
-			}
-
-			if _, ok := selected.ResolveBinary(); !ok {
-				logger.Error("tui.commands: agent binary not found provider=%s", selected.Name())
-				a.QueueUpdateDraw(func() {
-					a.updateStatusBarWithError(fmt.Errorf("agent binary not found for %s", selected.Name()))
-				})
-				return
-			}
-
-			options := agents.AgentRunOptions{
-				Workspace: workspace,
-				Model:     strings.TrimSpace(a.config.AgentModel),
-				Sandbox:   strings.TrimSpace(a.config.AgentSandbox),
-			}
-
-			ctx, cancel := context.WithCancel(context.Background())
-			a.QueueUpdateDraw(func() {
-				title := fmt.Sprintf(" %s Output ", selected.Name())
-				a.agentOutputModal.Show(title, cancel)
-				a.agentOutputModal.AppendLine(fmt.Sprintf("Starting %s agent run...", selected.Name()))
-			})
-
-			runErr := runner.Run(ctx, selected, prompt, issueContext, options, func(event agents.AgentEvent) {
-				a.agentOutputModal.AppendEvent(event)
-			}, func(line string) {
-				a.agentOutputModal.AppendRawLine(line)
-			}, func(runErr error) {
-				a.agentOutputModal.AppendLine(fmt.Sprintf("error: %v", runErr))
-			})
-
-			a.agentOutputModal.StopSpinner()
-
-			if runErr != nil {
-				a.QueueUpdateDraw(func() {
-					a.agentOutputModal.AppendLine(fmt.Sprintf("error: %v", runErr))
-				})
-				return
-			}
-
-			a.agentOutputModal.AppendLine("Agent run completed.")
-		}()
+		go a.runAgent(agentRunRequest{
+			IssueID:   issueID,
+			Prompt:    prompt,
+			Workspace: strings.TrimSpace(workspace),
+			Provider:  a.config.AgentProvider,
+			Model:     strings.TrimSpace(a.config.AgentModel),
+			Sandbox:   strings.TrimSpace(a.config.AgentSandbox),
+		})
 	})
 }
 
@@ -137,7 +78,11 @@ func DefaultCommands(app *App) []Command {
 	if app != nil && app.agentRunner != nil && app.agentRunner.LookPath != nil {
 		lookPath = app.agentRunner.LookPath
 	}
-	availableProviders := agents.AvailableProviderKeys(lookPath)
+	var agentCommands []config.AgentCommand
+	if app != nil {
+		agentCommands = app.config.AgentCommands
+	}
+	availableProviders := agents.AvailableProviderKeys(agentCommands, lookPath)
 
 	commands := []Command{
 		{
@@ -175,6 +120,99 @@ func DefaultCommands(app *App) []Command {
 				a.ShowSettingsModal()
 			},
 		},
+		{
+			ID:       "switch_account",
+			Title:    "Switch account",
+			Keywords: []string{"account", "profile", "workspace", "switch", "login"},
+			Run: func(a *App) {
+				a.showAccountPicker()
+			},
+		},
+		{
+			ID:       "toggle_board",
+			Title:    "Toggle board view",
+			Keywords: []string{"board", "kanban", "table", "layout", "columns", "view"},
+			Run: func(a *App) {
+				a.toggleIssuesLayout()
+			},
+		},
+		{
+			ID:       "save_view",
+			Title:    "Save current view",
+			Keywords: []string{"view", "views", "save", "filter", "bookmark"},
+			Run: func(a *App) {
+				a.showSaveViewPrompt()
+			},
+		},
+		{
+			ID:       "rename_view",
+			Title:    "Rename view",
+			Keywords: []string{"view", "views", "rename", "filter"},
+			Run: func(a *App) {
+				a.showRenameViewPicker()
+			},
+		},
+		{
+			ID:       "delete_view",
+			Title:    "Delete view",
+			Keywords: []string{"view", "views", "delete", "remove", "filter"},
+			Run: func(a *App) {
+				a.showDeleteViewPicker()
+			},
+		},
+		{
+			ID:       "set_default_view",
+			Title:    "Set startup view",
+			Keywords: []string{"view", "views", "default", "startup", "filter"},
+			Run: func(a *App) {
+				a.showDefaultViewPicker()
+			},
+		},
+		{
+			ID:           "open_inbox",
+			Title:        "Open inbox",
+			Keywords:     []string{"inbox", "notifications", "mentions", "I"},
+			ShortcutRune: 'I',
+			Run: func(a *App) {
+				a.ShowInbox()
+			},
+		},
+		{
+			ID:       "mark_notification_read",
+			Title:    "Mark notification read",
+			Keywords: []string{"inbox", "notification", "notifications", "read"},
+			Run: func(a *App) {
+				a.runNotificationCommand(func(notifications []linearapi.Notification) {
+					a.markNotificationsRead(notifications, true)
+				})
+			},
+		},
+		{
+			ID:       "mark_notification_unread",
+			Title:    "Mark notification unread",
+			Keywords: []string{"inbox", "notification", "notifications", "unread"},
+			Run: func(a *App) {
+				a.runNotificationCommand(func(notifications []linearapi.Notification) {
+					a.markNotificationsRead(notifications, false)
+				})
+			},
+		},
+		{
+			ID:       "snooze_notification",
+			Title:    "Snooze notification",
+			Keywords: []string{"inbox", "notification", "notifications", "snooze", "later", "remind"},
+			Run: func(a *App) {
+				a.runNotificationCommand(a.showSnoozePicker)
+			},
+		},
+		{
+			ID:       "archive_notification",
+			Title:    "Archive notification",
+			Keywords: []string{"inbox", "notification", "notifications", "archive", "done"},
+			Run: func(a *App) {
+				a.runNotificationCommand(a.archiveNotifications)
+			},
+		},
 		{
 			ID:       "edit_prompt_templates",
 			Title:    "Edit agent prompt templates",
@@ -252,15 +290,31 @@ func DefaultCommands(app *App) []Command {
 		{
 			ID:       "ask_agent",
 			Title:    "Ask agent about selected issue",
-			Keywords: []string{"agent", "ai", "claude", "cursor", "assistant"},
+			Keywords: []string{"agent", "ai", "claude", "cursor", "codex", "gemini", "assistant"},
 			Run:      handleAskAgent,
 		},
+		{
+			ID:       "agent_runs",
+			Title:    "Agent runs",
+			Keywords: []string{"agent", "runs", "history", "transcript", "resume", "rerun"},
+			Run: func(a *App) {
+				a.showAgentRuns()
+			},
+		},
 		{
 			ID:           "assign_me",
 			Title:        "Assign to me",
 			Keywords:     []string{"assign", "me", "self", "take"},
 			ShortcutRune: 'm',
 			Run: func(a *App) {
+				if marked := a.markedIssues(); len(marked) > 0 {
+					user := a.GetCurrentUser()
+					if user == nil {
+						return
+					}
+					a.bulkAssign(marked, user.ID)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				user := a.GetCurrentUser()
 				if issue == nil || user == nil {
@@ -268,7 +322,7 @@ func DefaultCommands(app *App) []Command {
 				}
 				go func() {
 					ctx := context.Background()
-					_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 						ID:         issue.ID,
 						AssigneeID: &user.ID,
 					})
@@ -290,6 +344,10 @@ func DefaultCommands(app *App) []Command {
 			Keywords:     []string{"unassign", "remove", "clear assignee"},
 			ShortcutRune: 'u',
 			Run: func(a *App) {
+				if marked := a.markedIssues(); len(marked) > 0 {
+					a.bulkAssign(marked, "")
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -297,7 +355,7 @@ func DefaultCommands(app *App) []Command {
 				emptyAssignee := ""
 				go func() {
 					ctx := context.Background()
-					_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 						ID:         issue.ID,
 						AssigneeID: &emptyAssignee,
 					})
@@ -319,6 +377,10 @@ func DefaultCommands(app *App) []Command {
 			Keywords:     []string{"archive", "delete", "remove"},
 			ShortcutRune: 'x',
 			Run: func(a *App) {
+				if marked := a.markedIssues(); len(marked) > 0 {
+					a.bulkArchive(marked)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -345,6 +407,10 @@ func DefaultCommands(app *App) []Command {
 			Keywords:     []string{"status", "state", "workflow", "todo", "progress", "done"},
 			ShortcutRune: 's',
 			Run: func(a *App) {
+				if marked := a.markedIssues(); len(marked) > 0 {
+					a.bulkChangeStatus(marked)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -352,7 +418,7 @@ func DefaultCommands(app *App) []Command {
 				a.ShowStatusPicker(func(stateID string) {
 					go func() {
 						ctx := context.Background()
-						_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+						_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 							ID:      issue.ID,
 							StateID: &stateID,
 						})
@@ -369,12 +435,47 @@ func DefaultCommands(app *App) []Command {
 				})
 			},
 		},
+		{
+			ID:       "move_to_cycle",
+			Title:    "Move to cycle",
+			Keywords: []string{"cycle", "sprint", "iteration", "schedule"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowCyclePicker(issue.TeamID, func(cycleID string) {
+					go func() {
+						ctx := context.Background()
+						_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
+							ID:      issue.ID,
+							CycleID: &cycleID,
+						})
+						a.QueueUpdateDraw(func() {
+							if err != nil {
+								logger.ErrorWithErr(err, "tui.commands: failed to move issue to cycle issue=%s", issue.Identifier)
+								a.updateStatusBarWithError(err)
+								return
+							}
+							logger.Info("tui.commands: moved issue to cycle issue=%s cycle_id=%s", issue.Identifier, cycleID)
+							go a.refreshIssues(issue.ID)
+						})
+					}()
+				})
+			},
+		},
 		{
 			ID:           "assign_user",
 			Title:        "Assign to user",
 			Keywords:     []string{"assign", "user", "team", "member"},
 			ShortcutRune: 'a',
 			Run: func(a *App) {
+				if marked := a.markedIssues(); len(marked) > 0 {
+					a.ShowUserPicker(func(userID string) {
+						a.bulkAssign(marked, userID)
+					})
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -382,7 +483,7 @@ func DefaultCommands(app *App) []Command {
 				a.ShowUserPicker(func(userID string) {
 					go func() {
 						ctx := context.Background()
-						_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+						_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 							ID:         issue.ID,
 							AssigneeID: &userID,
 						})
@@ -399,6 +500,35 @@ func DefaultCommands(app *App) []Command {
 				})
 			},
 		},
+		{
+			ID:              "set_priority",
+			Title:           "Set priority",
+			Keywords:        []string{"priority", "urgent", "high", "normal", "medium", "low", "triage"},
+			ShortcutDisplay: "0-4", // Handled in the issues pane
+			Run: func(a *App) {
+				if len(a.markedIssues()) == 0 && a.GetSelectedIssue() == nil {
+					return
+				}
+				a.ShowPriorityPicker(a.setPriority)
+			},
+		},
+		{
+			ID:              "select_all",
+			Title:           "Select all issues in section",
+			Keywords:        []string{"select", "all", "mark", "bulk", "multi"},
+			ShortcutDisplay: "Ctrl+A",
+			Run: func(a *App) {
+				a.markAllInSection(a.activeIssuesSection)
+			},
+		},
+		{
+			ID:       "clear_selection",
+			Title:    "Clear selection",
+			Keywords: []string{"clear", "unselect", "deselect", "unmark", "bulk"},
+			Run: func(a *App) {
+				a.clearIssueMarks()
+			},
+		},
 		{
 			ID:           "create_issue",
 			Title:        "Create new issue",
@@ -426,12 +556,49 @@ func DefaultCommands(app *App) []Command {
 				a.ShowEditTitleModal()
 			},
 		},
+		{
+			ID:           "edit_description",
+			Title:        "Edit description in $EDITOR",
+			Keywords:     []string{"edit", "description", "body", "editor", "markdown"},
+			ShortcutRune: 'E',
+			Run: func(a *App) {
+				a.ShowEditDescription()
+			},
+		},
+		{
+			ID:       "reply_comment",
+			Title:    "Reply to comment",
+			Keywords: []string{"comment", "reply", "thread", "respond"},
+			Run: func(a *App) {
+				a.ShowReplyToComment()
+			},
+		},
+		{
+			ID:       "edit_comment",
+			Title:    "Edit comment",
+			Keywords: []string{"comment", "edit", "update", "fix"},
+			Run: func(a *App) {
+				a.ShowEditComment()
+			},
+		},
+		{
+			ID:       "delete_comment",
+			Title:    "Delete comment",
+			Keywords: []string{"comment", "delete", "remove"},
+			Run: func(a *App) {
+				a.ShowDeleteComment()
+			},
+		},
 		{
 			ID:           "edit_labels",
 			Title:        "Edit issue labels",
 			Keywords:     []string{"labels", "label", "tag", "tags"},
 			ShortcutRune: 'g', // 'g' for tags (since 'l' is used for vim navigation)
 			Run: func(a *App) {
+				if marked := a.markedIssues(); len(marked) > 0 {
+					a.bulkEditLabels(marked)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -521,8 +688,8 @@ func DefaultCommands(app *App) []Command {
 					}
 				}
 
-				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
+				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
 			},
 		},
 		{
@@ -574,8 +741,8 @@ func DefaultCommands(app *App) []Command {
 					}
 				}
 
-				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
+				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.markedIssueIDs, a.changedIssueIDs, a.theme)
 			},
 		},
 		{
@@ -598,6 +765,10 @@ func DefaultCommands(app *App) []Command {
 			Keywords:     []string{"set", "parent", "link"},
 			ShortcutRune: 'i',
 			Run: func(a *App) {
+				if marked := a.markedIssues(); len(marked) > 0 {
+					a.bulkSetParent(marked)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -610,7 +781,7 @@ func DefaultCommands(app *App) []Command {
 				a.ShowParentIssuePicker(func(parentID string) {
 					go func() {
 						ctx := context.Background()
-						_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+						_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 							ID:       issue.ID,
 							ParentID: &parentID,
 						})
@@ -640,7 +811,7 @@ func DefaultCommands(app *App) []Command {
 				emptyParent := ""
 				go func() {
 					ctx := context.Background()
-					_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					_, err := a.updateIssue(ctx, linearapi.UpdateIssueInput{
 						ID:       issue.ID,
 						ParentID: &emptyParent,
 					})
@@ -656,6 +827,64 @@ func DefaultCommands(app *App) []Command {
 				}()
 			},
 		},
+		{
+			ID:       "add_relation",
+			Title:    "Add relation",
+			Keywords: []string{"relation", "blocks", "blocked", "blocker", "related", "duplicate", "dependency", "link"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowRelationTypePicker(func(relType linearapi.RelationType) {
+					a.ShowRelatedIssuePicker(issue.ID, func(relatedID string) {
+						go func() {
+							ctx := context.Background()
+							err := a.GetAPI().CreateIssueRelation(ctx, linearapi.CreateIssueRelationInput{
+								IssueID:        issue.ID,
+								RelatedIssueID: relatedID,
+								Type:           relType,
+							})
+							a.QueueUpdateDraw(func() {
+								if err != nil {
+									logger.ErrorWithErr(err, "tui.commands: failed to add relation issue=%s type=%s", issue.Identifier, relType)
+									a.updateStatusBarWithError(err)
+									return
+								}
+								logger.Info("tui.commands: added relation issue=%s type=%s", issue.Identifier, relType)
+								go a.refreshIssues(issue.ID)
+							})
+						}()
+					})
+				})
+			},
+		},
+		{
+			ID:       "remove_relation",
+			Title:    "Remove relation",
+			Keywords: []string{"remove", "relation", "unblock", "unlink", "dependency"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowIssueRelationPicker(issue, func(relationID string) {
+					go func() {
+						ctx := context.Background()
+						err := a.GetAPI().DeleteIssueRelation(ctx, relationID)
+						a.QueueUpdateDraw(func() {
+							if err != nil {
+								logger.ErrorWithErr(err, "tui.commands: failed to remove relation issue=%s", issue.Identifier)
+								a.updateStatusBarWithError(err)
+								return
+							}
+							logger.Info("tui.commands: removed relation issue=%s relation_id=%s", issue.Identifier, relationID)
+							go a.refreshIssues(issue.ID)
+						})
+					}()
+				})
+			},
+		},
 		{
 			ID:           "add_comment",
 			Title:        "Add comment",
@@ -666,7 +895,20 @@ func DefaultCommands(app *App) []Command {
 				if issue == nil {
 					return
 				}
-				a.createCommentModal.Show(issue.ID, a.handleCreateComment)
+				a.createCommentModal.Show(*issue, a.handleCreateComment)
+			},
+		},
+		{
+			ID:       "sync_pending",
+			Title:    "Sync pending changes",
+			Keywords: []string{"sync", "offline", "pending", "queue", "replay"},
+			Run: func(a *App) {
+				if a.pendingMutationCount() == 0 {
+					a.statusBar.SetText(a.themeTags.SecondaryText + "No pending changes[-]")
+					return
+				}
+				a.statusBar.SetText(a.themeTags.Warning + "Syncing pending changes...[-]")
+				go a.replayOfflineQueue()
 			},
 		},
 	}
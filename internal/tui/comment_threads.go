@@ -0,0 +1,226 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Picker item IDs for confirming a comment deletion.
+const (
+	deleteCommentConfirm = "delete"
+	deleteCommentCancel  = "cancel"
+)
+
+// commentRow is a comment in thread order with its reply depth.
+type commentRow struct {
+	Comment linearapi.Comment
+	Depth   int
+}
+
+// threadComments orders comments as threads: each top-level comment is
+// followed by its replies, oldest first. Replies whose parent is missing are
+// shown as top-level comments.
+func threadComments(comments []linearapi.Comment) []commentRow {
+	byID := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		byID[comment.ID] = true
+	}
+
+	sorted := append([]linearapi.Comment(nil), comments...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	replies := make(map[string][]linearapi.Comment)
+	var roots []linearapi.Comment
+	for _, comment := range sorted {
+		if comment.ParentID != "" && byID[comment.ParentID] && comment.ParentID != comment.ID {
+			replies[comment.ParentID] = append(replies[comment.ParentID], comment)
+			continue
+		}
+		roots = append(roots, comment)
+	}
+
+	rows := make([]commentRow, 0, len(comments))
+	var appendThread func(comment linearapi.Comment, depth int)
+	appendThread = func(comment linearapi.Comment, depth int) {
+		rows = append(rows, commentRow{Comment: comment, Depth: depth})
+		for _, reply := range replies[comment.ID] {
+			appendThread(reply, depth+1)
+		}
+	}
+	for _, root := range roots {
+		appendThread(root, 0)
+	}
+	return rows
+}
+
+// commentRegionID returns the comments view region that highlights a comment header.
+func commentRegionID(commentID string) string {
+	return "comment-" + commentID
+}
+
+// isCommentsViewFocused reports whether the comments sub-view of the details pane has focus.
+func (a *App) isCommentsViewFocused() bool {
+	return a.focusedPane == FocusDetails && a.focusedDetailsView && a.detailsCommentsVisible
+}
+
+// highlightFocusedComment highlights the focused comment in the comments view.
+func (a *App) highlightFocusedComment() {
+	if a.focusedCommentID == "" {
+		a.detailsCommentsView.Highlight()
+		return
+	}
+	a.detailsCommentsView.Highlight(commentRegionID(a.focusedCommentID))
+	a.detailsCommentsView.ScrollToHighlight()
+}
+
+// ensureCommentFocus focuses the first comment when none is focused.
+func (a *App) ensureCommentFocus() {
+	if a.focusedCommentID == "" {
+		a.moveCommentFocus(1)
+	}
+}
+
+// moveCommentFocus moves the focused comment by delta in thread order.
+func (a *App) moveCommentFocus(delta int) {
+	a.issuesMu.RLock()
+	issue := a.selectedIssue
+	a.issuesMu.RUnlock()
+	if issue == nil || len(issue.Comments) == 0 {
+		return
+	}
+
+	rows := threadComments(issue.Comments)
+	index := -1
+	for i, row := range rows {
+		if row.Comment.ID == a.focusedCommentID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		index = 0
+	} else {
+		index = max(0, min(len(rows)-1, index+delta))
+	}
+	a.focusedCommentID = rows[index].Comment.ID
+	a.highlightFocusedComment()
+}
+
+// focusedComment returns the comment focused in the comments view.
+func (a *App) focusedComment() (linearapi.Issue, linearapi.Comment, error) {
+	a.issuesMu.RLock()
+	issue := a.selectedIssue
+	a.issuesMu.RUnlock()
+	if issue == nil || !a.focusedDetailsView || !a.detailsCommentsVisible || a.focusedCommentID == "" {
+		return linearapi.Issue{}, linearapi.Comment{}, fmt.Errorf("focus a comment in the comments view first")
+	}
+	for _, comment := range issue.Comments {
+		if comment.ID == a.focusedCommentID {
+			return *issue, comment, nil
+		}
+	}
+	return linearapi.Issue{}, linearapi.Comment{}, fmt.Errorf("focused comment not found")
+}
+
+// commentAuthor returns the display name of a comment's author.
+func commentAuthor(comment linearapi.Comment) string {
+	if comment.Author.DisplayName != "" {
+		return comment.Author.DisplayName
+	}
+	return comment.Author.Name
+}
+
+// ShowReplyToComment opens the comment form to reply to the focused comment.
+// Replies to a reply are added to the same thread.
+func (a *App) ShowReplyToComment() {
+	issue, comment, err := a.focusedComment()
+	if err != nil {
+		a.updateStatusBarWithError(err)
+		return
+	}
+
+	parentID := comment.ID
+	if comment.ParentID != "" {
+		parentID = comment.ParentID
+	}
+	header := fmt.Sprintf("Reply to %s", commentAuthor(comment))
+	a.createCommentModal.ShowWithText(issue, "Reply", header, "", func(issueID, body string) {
+		a.submitComment(linearapi.CreateCommentInput{
+			IssueID:  issueID,
+			Body:     body,
+			ParentID: parentID,
+		})
+	})
+}
+
+// ShowEditComment opens the comment form to edit the focused comment.
+func (a *App) ShowEditComment() {
+	issue, comment, err := a.focusedComment()
+	if err == nil && !comment.Author.IsMe {
+		err = fmt.Errorf("only your own comments can be edited")
+	}
+	if err != nil {
+		a.updateStatusBarWithError(err)
+		return
+	}
+
+	a.createCommentModal.ShowWithText(issue, "Edit Comment", "Edit Comment", comment.Body, func(issueID, body string) {
+		if body == comment.Body {
+			return
+		}
+		go func() {
+			_, err := a.GetAPI().UpdateComment(context.Background(), comment.ID, body)
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.commands: failed to update comment issue=%s comment=%s", issue.Identifier, comment.ID)
+					a.updateStatusBarWithError(err)
+					return
+				}
+				logger.Info("tui.commands: updated comment issue=%s comment=%s", issue.Identifier, comment.ID)
+				a.reloadSelectedIssueDetails(issueID)
+			})
+		}()
+	})
+}
+
+// ShowDeleteComment asks for confirmation and deletes the focused comment.
+func (a *App) ShowDeleteComment() {
+	issue, comment, err := a.focusedComment()
+	if err == nil && !comment.Author.IsMe {
+		err = fmt.Errorf("only your own comments can be deleted")
+	}
+	if err != nil {
+		a.updateStatusBarWithError(err)
+		return
+	}
+
+	items := []PickerItem{
+		{ID: deleteCommentCancel, Label: "Cancel"},
+		{ID: deleteCommentConfirm, Label: "Delete comment"},
+	}
+	a.pickerActive = true
+	a.pickerModal.Show("Delete this comment?", items, func(item PickerItem) {
+		if item.ID != deleteCommentConfirm {
+			return
+		}
+		go func() {
+			err := a.GetAPI().DeleteComment(context.Background(), comment.ID)
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.commands: failed to delete comment issue=%s comment=%s", issue.Identifier, comment.ID)
+					a.updateStatusBarWithError(err)
+					return
+				}
+				logger.Info("tui.commands: deleted comment issue=%s comment=%s", issue.Identifier, comment.ID)
+				a.focusedCommentID = ""
+				a.reloadSelectedIssueDetails(issue.ID)
+			})
+		}()
+	})
+}
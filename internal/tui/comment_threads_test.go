@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestThreadComments(t *testing.T) {
+	at := func(minute int) time.Time {
+		return time.Date(2025, 1, 1, 12, minute, 0, 0, time.UTC)
+	}
+	comments := []linearapi.Comment{
+		{ID: "reply-2", ParentID: "root-1", CreatedAt: at(5)},
+		{ID: "root-1", CreatedAt: at(1)},
+		{ID: "root-2", CreatedAt: at(2)},
+		{ID: "reply-1", ParentID: "root-1", CreatedAt: at(3)},
+		{ID: "orphan", ParentID: "deleted", CreatedAt: at(4)},
+	}
+
+	rows := threadComments(comments)
+
+	want := []struct {
+		id    string
+		depth int
+	}{
+		{"root-1", 0},
+		{"reply-1", 1},
+		{"reply-2", 1},
+		{"root-2", 0},
+		{"orphan", 0},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("threadComments() returned %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		if rows[i].Comment.ID != w.id || rows[i].Depth != w.depth {
+			t.Errorf("row %d = %s (depth %d), want %s (depth %d)", i, rows[i].Comment.ID, rows[i].Depth, w.id, w.depth)
+		}
+	}
+}
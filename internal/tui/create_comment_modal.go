@@ -9,14 +9,18 @@ import (
 	"github.com/roeyazroel/linear-tui/internal/logger"
 )
 
-// CreateCommentModal manages the create comment form overlay.
+// CreateCommentModal manages the comment form overlay used to add, reply to,
+// and edit comments.
 type CreateCommentModal struct {
-	app       *App
-	modal     *tview.Flex
-	form      *tview.Form
-	bodyField *tview.TextArea
-	issueID   string
-	onCreate  func(issueID, body string)
+	app          *App
+	modal        *tview.Flex
+	modalContent *tview.Flex
+	headerView   *tview.TextView
+	form         *tview.Form
+	bodyField    *tview.TextArea
+	mentions     *MentionCompleter
+	issueID      string
+	onCreate     func(issueID, body string)
 }
 
 // NewCreateCommentModal creates a new create comment modal.
@@ -41,10 +45,11 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 			ccm.bodyField = textArea
 		}
 	}
+	ccm.mentions = NewMentionCompleter(app, ccm.bodyField)
 
 	// Add action buttons
 	ccm.form.AddButton("Comment", func() {
-		body := ccm.bodyField.GetText()
+		body := ccm.body()
 		ccm.Hide()
 		if ccm.onCreate != nil && body != "" {
 			ccm.onCreate(ccm.issueID, body)
@@ -55,32 +60,32 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 	})
 
 	// Create header with instructions
-	headerView := tview.NewTextView()
-	headerView.SetText("Add Comment")
-	headerView.SetTextColor(app.theme.Accent)
-	headerView.SetBackgroundColor(app.theme.HeaderBg)
+	ccm.headerView = tview.NewTextView()
+	ccm.headerView.SetText("Add Comment")
+	ccm.headerView.SetTextColor(app.theme.Accent)
+	ccm.headerView.SetBackgroundColor(app.theme.HeaderBg)
 
 	// Create help text
 	helpView := tview.NewTextView()
-	helpView.SetText("Esc: cancel • Ctrl+Enter / Cmd+Enter: submit")
+	helpView.SetText("@: mention • #/ENG-: link issue • Esc: cancel • Ctrl+Enter: submit")
 	helpView.SetTextColor(app.theme.SecondaryText)
 	helpView.SetBackgroundColor(app.theme.HeaderBg)
 	helpView.SetTextAlign(tview.AlignCenter)
 
 	// Build modal content
-	modalContent := tview.NewFlex().
+	ccm.modalContent = tview.NewFlex().
 		SetDirection(tview.FlexRow).
-		AddItem(headerView, 1, 0, false).
+		AddItem(ccm.headerView, 1, 0, false).
 		AddItem(ccm.form, 0, 1, true).
 		AddItem(helpView, 1, 0, false)
-	modalContent.Box = tview.NewBox().SetBackgroundColor(app.theme.HeaderBg)
-	modalContent.SetBackgroundColor(app.theme.HeaderBg).
+	ccm.modalContent.Box = tview.NewBox().SetBackgroundColor(app.theme.HeaderBg)
+	ccm.modalContent.SetBackgroundColor(app.theme.HeaderBg).
 		SetBorder(true).
 		SetBorderColor(app.theme.Accent).
 		SetTitle(" New Comment ").
 		SetTitleColor(app.theme.Foreground)
 	padding := app.density.ModalPadding
-	modalContent.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
+	ccm.modalContent.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
 
 	// Center the modal on screen
 	ccm.modal = tview.NewFlex().
@@ -88,7 +93,7 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 		AddItem(tview.NewFlex().
 			SetDirection(tview.FlexRow).
 			AddItem(nil, 0, 1, false).
-			AddItem(modalContent, 18, 0, true).
+			AddItem(ccm.modalContent, 18, 0, true).
 			AddItem(nil, 0, 1, false), 75, 0, true).
 		AddItem(nil, 0, 1, false)
 	ccm.modal.SetBackgroundColor(app.theme.Background)
@@ -97,12 +102,22 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 }
 
 // Show displays the create comment modal.
-func (ccm *CreateCommentModal) Show(issueID string, onCreate func(issueID, body string)) {
-	ccm.issueID = issueID
+func (ccm *CreateCommentModal) Show(issue linearapi.Issue, onCreate func(issueID, body string)) {
+	ccm.ShowWithText(issue, "New Comment", "Add Comment", "", onCreate)
+}
+
+// ShowWithText displays the comment modal with a custom title and header,
+// prefilled with body (used for replies and edits). Mentions are completed
+// from the members of the issue's team.
+func (ccm *CreateCommentModal) ShowWithText(issue linearapi.Issue, title, header, body string, onCreate func(issueID, body string)) {
+	ccm.issueID = issue.ID
 	ccm.onCreate = onCreate
+	ccm.modalContent.SetTitle(" " + title + " ")
+	ccm.headerView.SetText(header)
 
 	// Reset form field
-	ccm.bodyField.SetText("", true)
+	ccm.bodyField.SetText(body, true)
+	ccm.mentions.Reset(issue.TeamID)
 
 	// Show modal
 	ccm.app.pages.AddPage("create_comment", ccm.modal, true, true)
@@ -112,12 +127,16 @@ func (ccm *CreateCommentModal) Show(issueID string, onCreate func(issueID, body
 
 // Hide hides the create comment modal.
 func (ccm *CreateCommentModal) Hide() {
+	ccm.mentions.hide()
 	ccm.app.pages.RemovePage("create_comment")
 	ccm.app.updateFocus()
 }
 
 // HandleKey handles keyboard input for the create comment modal.
 func (ccm *CreateCommentModal) HandleKey(event *tcell.EventKey) *tcell.EventKey {
+	if event = ccm.mentions.HandleKey(event); event == nil {
+		return nil
+	}
 	switch event.Key() {
 	case tcell.KeyEscape:
 		ccm.Hide()
@@ -127,7 +146,7 @@ func (ccm *CreateCommentModal) HandleKey(event *tcell.EventKey) *tcell.EventKey
 		mod := event.Modifiers()
 		if mod&tcell.ModCtrl != 0 || mod&tcell.ModMeta != 0 {
 			// Submit comment
-			body := ccm.bodyField.GetText()
+			body := ccm.body()
 			if body != "" {
 				ccm.Hide()
 				if ccm.onCreate != nil {
@@ -140,6 +159,11 @@ func (ccm *CreateCommentModal) HandleKey(event *tcell.EventKey) *tcell.EventKey
 	return event
 }
 
+// body returns the comment text with completed mentions expanded.
+func (ccm *CreateCommentModal) body() string {
+	return ccm.mentions.Expand(ccm.bodyField.GetText())
+}
+
 // GetModal returns the modal flex for adding to pages.
 func (ccm *CreateCommentModal) GetModal() *tview.Flex {
 	return ccm.modal
@@ -147,43 +171,54 @@ func (ccm *CreateCommentModal) GetModal() *tview.Flex {
 
 // handleCreateComment handles comment creation.
 func (a *App) handleCreateComment(issueID, body string) {
+	a.submitComment(linearapi.CreateCommentInput{
+		IssueID: issueID,
+		Body:    body,
+	})
+}
+
+// submitComment creates a comment or reply and reloads the issue details.
+func (a *App) submitComment(input linearapi.CreateCommentInput) {
 	go func() {
 		ctx := context.Background()
-		_, err := a.GetAPI().CreateComment(ctx, linearapi.CreateCommentInput{
-			IssueID: issueID,
-			Body:    body,
-		})
+		_, err := a.createComment(ctx, input)
 
 		a.app.QueueUpdateDraw(func() {
 			if err != nil {
-				logger.ErrorWithErr(err, "tui.app: failed to create comment issue=%s", issueID)
+				logger.ErrorWithErr(err, "tui.app: failed to create comment issue=%s", input.IssueID)
 				a.updateStatusBarWithError(err)
 				return
 			}
 
-			logger.Info("tui.app: created comment issue=%s", issueID)
-
-			// Refresh the selected issue to show the new comment
-			a.issuesMu.RLock()
-			selectedIssue := a.selectedIssue
-			a.issuesMu.RUnlock()
-			if selectedIssue != nil && selectedIssue.ID == issueID {
-				a.fetchingIssueID = issueID
-				go func() {
-					fullIssue, fetchErr := a.api.FetchIssueByID(ctx, issueID)
-					a.app.QueueUpdateDraw(func() {
-						if a.fetchingIssueID == issueID {
-							if fetchErr != nil {
-								logger.ErrorWithErr(fetchErr, "tui.app: failed to refresh issue after comment creation issue=%s", issueID)
-								return
-							}
-							a.issuesMu.Lock()
-							a.selectedIssue = &fullIssue
-							a.issuesMu.Unlock()
-							a.updateDetailsView()
-						}
-					})
-				}()
+			logger.Info("tui.app: created comment issue=%s parent=%s", input.IssueID, input.ParentID)
+			a.reloadSelectedIssueDetails(input.IssueID)
+		})
+	}()
+}
+
+// reloadSelectedIssueDetails re-fetches the issue shown in the details pane
+// (e.g. to show comment changes) if it is still selected.
+func (a *App) reloadSelectedIssueDetails(issueID string) {
+	a.issuesMu.RLock()
+	selectedIssue := a.selectedIssue
+	a.issuesMu.RUnlock()
+	if selectedIssue == nil || selectedIssue.ID != issueID {
+		return
+	}
+
+	a.fetchingIssueID = issueID
+	go func() {
+		fullIssue, fetchErr := a.services().fetchIssueByID(context.Background(), issueID)
+		a.app.QueueUpdateDraw(func() {
+			if a.fetchingIssueID == issueID {
+				if fetchErr != nil {
+					logger.ErrorWithErr(fetchErr, "tui.app: failed to refresh issue after comment change issue=%s", issueID)
+					return
+				}
+				a.issuesMu.Lock()
+				a.selectedIssue = &fullIssue
+				a.issuesMu.Unlock()
+				a.updateDetailsView()
 			}
 		})
 	}()
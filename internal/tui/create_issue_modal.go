@@ -16,6 +16,7 @@ type CreateIssueModal struct {
 	form          *tview.Form
 	assigneeField *tview.DropDown
 	priorityField *tview.DropDown
+	mentions      *MentionCompleter
 	teamID        string
 	projectID     string
 	assigneeID    string
@@ -47,6 +48,11 @@ func NewCreateIssueModal(app *App) *CreateIssueModal {
 
 	// Add description field
 	cm.form.AddTextArea("Description", "", 60, 4, 0, nil)
+	if descItem := cm.form.GetFormItemByLabel("Description"); descItem != nil {
+		if textArea, ok := descItem.(*tview.TextArea); ok {
+			cm.mentions = NewMentionCompleter(app, textArea)
+		}
+	}
 
 	// Add assignee dropdown - will be populated when shown
 	cm.form.AddDropDown("Assignee", []string{"Unassigned"}, 0, func(_ string, index int) {
@@ -99,7 +105,7 @@ func NewCreateIssueModal(app *App) *CreateIssueModal {
 		}
 		if descItem := cm.form.GetFormItemByLabel("Description"); descItem != nil {
 			if textArea, ok := descItem.(*tview.TextArea); ok {
-				desc = textArea.GetText()
+				desc = cm.mentions.Expand(textArea.GetText())
 			}
 		}
 		cm.Hide()
@@ -119,7 +125,7 @@ func NewCreateIssueModal(app *App) *CreateIssueModal {
 
 	// Create help text
 	helpView := tview.NewTextView()
-	helpView.SetText("Tab: next field • Enter: open dropdown • Esc: cancel")
+	helpView.SetText("Tab: next field • Enter: open dropdown • @: mention • Esc: cancel")
 	helpView.SetTextColor(app.theme.SecondaryText)
 	helpView.SetBackgroundColor(app.theme.HeaderBg)
 	helpView.SetTextAlign(tview.AlignCenter)
@@ -171,6 +177,7 @@ func (cm *CreateIssueModal) Show(teamID, projectID string, onCreate func(title,
 			_ = textArea.SetText("", true)
 		}
 	}
+	cm.mentions.Reset(teamID)
 
 	// Reset selections
 	cm.assigneeID = ""
@@ -240,12 +247,16 @@ func (cm *CreateIssueModal) populateAssigneeDropdown(users []linearapi.User) {
 
 // Hide hides the create issue modal.
 func (cm *CreateIssueModal) Hide() {
+	cm.mentions.hide()
 	cm.app.pages.RemovePage("create_issue")
 	cm.app.updateFocus()
 }
 
 // HandleKey handles keyboard input for the create issue modal.
 func (cm *CreateIssueModal) HandleKey(event *tcell.EventKey) *tcell.EventKey {
+	if event = cm.mentions.HandleKey(event); event == nil {
+		return nil
+	}
 	if event.Key() == tcell.KeyEscape {
 		cm.Hide()
 		return nil
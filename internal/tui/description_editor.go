@@ -0,0 +1,234 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Picker item IDs offered when the description changed remotely during an
+// edit, or could not be checked for remote changes.
+const (
+	descriptionConflictMerge = "merge"
+	descriptionConflictAbort = "abort"
+	descriptionCheckRetry    = "retry"
+)
+
+// editorCommand returns the editor command line from $VISUAL or $EDITOR,
+// falling back to vi.
+func editorCommand(getenv func(string) string) []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{"vi"}
+}
+
+// runEditor suspends the UI and opens path in the user's editor.
+func (a *App) runEditor(path string) error {
+	args := append(editorCommand(os.Getenv), path)
+	logger.Debug("tui.commands: opening editor command=%s path=%s", args[0], path)
+
+	var runErr error
+	a.app.Suspend(func() {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		runErr = cmd.Run()
+	})
+	if runErr != nil {
+		return fmt.Errorf("run editor %s: %w", args[0], runErr)
+	}
+	return nil
+}
+
+// writeDescriptionFile writes a description to a new markdown temp file.
+func writeDescriptionFile(identifier, description string) (string, error) {
+	if identifier == "" {
+		identifier = "issue"
+	}
+	file, err := os.CreateTemp("", identifier+"-*.md")
+	if err != nil {
+		return "", fmt.Errorf("create description file: %w", err)
+	}
+	defer file.Close()
+
+	if description != "" && !strings.HasSuffix(description, "\n") {
+		description += "\n"
+	}
+	if _, err := file.WriteString(description); err != nil {
+		return "", fmt.Errorf("write description file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// ShowEditDescription opens the selected issue's description in the user's editor.
+func (a *App) ShowEditDescription() {
+	issue := a.GetSelectedIssue()
+	if issue == nil {
+		return
+	}
+
+	go func() {
+		// Start from the latest description so remote changes are detected reliably
+		base, err := a.services().fetchIssueByID(context.Background(), issue.ID)
+		if err != nil {
+			// The cached issue may be stale, so it cannot serve as the merge base
+			logger.ErrorWithErr(err, "tui.commands: failed to fetch issue before editing description issue=%s", issue.Identifier)
+			a.QueueUpdateDraw(func() {
+				a.updateStatusBarWithError(fmt.Errorf("load description of %s: %w", issue.Identifier, err))
+			})
+			return
+		}
+		a.editDescription(base, base.Description)
+	}()
+}
+
+// editDescription opens text in the editor and saves the result as the
+// description of base (see checkAndSaveDescription). It must not be called
+// from the UI goroutine.
+func (a *App) editDescription(base linearapi.Issue, text string) {
+	path, err := writeDescriptionFile(base.Identifier, text)
+	if err != nil {
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(err)
+		})
+		return
+	}
+
+	if err := a.runEditor(path); err != nil {
+		logger.ErrorWithErr(err, "tui.commands: editor failed issue=%s", base.Identifier)
+		_ = os.Remove(path)
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(err)
+		})
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(fmt.Errorf("read description file: %w", err))
+		})
+		return
+	}
+	edited := strings.TrimRight(string(data), "\n")
+
+	if hasMergeMarkers(edited) {
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(fmt.Errorf("description has unresolved conflict markers; edits kept in %s", path))
+		})
+		return
+	}
+	if edited == base.Description {
+		_ = os.Remove(path)
+		a.QueueUpdateDraw(func() {
+			a.statusBar.SetText(fmt.Sprintf("%sDescription unchanged[-]", a.themeTags.SecondaryText))
+		})
+		return
+	}
+
+	a.checkAndSaveDescription(base, edited, path)
+}
+
+// checkAndSaveDescription re-fetches the issue and saves edited as its
+// description. If the description changed remotely, the user can merge or
+// abort; if the issue cannot be fetched, the user can retry or abort. Edits
+// are never saved without the check. It must not be called from the UI
+// goroutine.
+func (a *App) checkAndSaveDescription(base linearapi.Issue, edited, path string) {
+	latest, err := a.services().fetchIssueByID(context.Background(), base.ID)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.commands: failed to check issue for remote changes issue=%s", base.Identifier)
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(fmt.Errorf("check %s for remote changes: %w", base.Identifier, err))
+			a.showDescriptionCheckFailed(base, edited, path)
+		})
+		return
+	}
+	if latest.UpdatedAt.Equal(base.UpdatedAt) || latest.Description == base.Description || latest.Description == edited {
+		a.saveDescription(base, edited, path)
+		return
+	}
+
+	logger.Info("tui.commands: description changed remotely during edit issue=%s", base.Identifier)
+	a.QueueUpdateDraw(func() {
+		a.showDescriptionConflict(base, latest, edited, path)
+	})
+}
+
+// showDescriptionCheckFailed offers to retry the remote change check of an
+// edited description, or to abort the edit.
+func (a *App) showDescriptionCheckFailed(base linearapi.Issue, edited, path string) {
+	items := []PickerItem{
+		{ID: descriptionCheckRetry, Label: "Retry"},
+		{ID: descriptionConflictAbort, Label: "Abort (keep my edits in a file)"},
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(fmt.Sprintf("Could not check %s for changes", base.Identifier), items, func(item PickerItem) {
+		if item.ID != descriptionCheckRetry {
+			a.abortDescriptionEdit(path)
+			return
+		}
+		go a.checkAndSaveDescription(base, edited, path)
+	})
+}
+
+// showDescriptionConflict offers a three-way merge of a description that was
+// edited both locally and remotely, or aborting the edit.
+func (a *App) showDescriptionConflict(base, latest linearapi.Issue, edited, path string) {
+	items := []PickerItem{
+		{ID: descriptionConflictMerge, Label: "Merge my edits with the remote changes"},
+		{ID: descriptionConflictAbort, Label: "Abort (keep my edits in a file)"},
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(fmt.Sprintf("%s changed remotely", base.Identifier), items, func(item PickerItem) {
+		if item.ID != descriptionConflictMerge {
+			a.abortDescriptionEdit(path)
+			return
+		}
+
+		merged, conflict := mergeDescriptions(base.Description, edited, latest.Description)
+		if conflict {
+			// Let the user resolve the conflict markers, then save against the remote version
+			_ = os.Remove(path)
+			go a.editDescription(latest, merged)
+			return
+		}
+		go a.saveDescription(latest, merged, path)
+	})
+}
+
+// abortDescriptionEdit reports an aborted edit, whose text stays in path.
+func (a *App) abortDescriptionEdit(path string) {
+	a.statusBar.SetText(fmt.Sprintf("%sEdit aborted; your description is in %s[-]", a.themeTags.Warning, path))
+}
+
+// saveDescription updates the issue description and removes the edit file on success.
+func (a *App) saveDescription(issue linearapi.Issue, description, path string) {
+	_, err := a.updateIssue(context.Background(), linearapi.UpdateIssueInput{
+		ID:          issue.ID,
+		Description: &description,
+	})
+	if err == nil {
+		_ = os.Remove(path)
+	}
+	a.QueueUpdateDraw(func() {
+		if err != nil {
+			logger.ErrorWithErr(err, "tui.commands: failed to update description issue=%s", issue.Identifier)
+			a.updateStatusBarWithError(fmt.Errorf("%w (edits kept in %s)", err, path))
+			return
+		}
+		logger.Info("tui.commands: updated description issue=%s", issue.Identifier)
+		go a.refreshIssues(issue.ID)
+	})
+}
@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestEditorCommand(t *testing.T) {
+	env := map[string]string{"EDITOR": "code --wait"}
+	getenv := func(name string) string { return env[name] }
+
+	if got := editorCommand(getenv); len(got) != 2 || got[0] != "code" || got[1] != "--wait" {
+		t.Errorf("editorCommand() = %q, want [code --wait]", got)
+	}
+	env["VISUAL"] = "nvim"
+	if got := editorCommand(getenv); len(got) != 1 || got[0] != "nvim" {
+		t.Errorf("editorCommand() = %q, want $VISUAL", got)
+	}
+	if got := editorCommand(func(string) string { return "" }); len(got) != 1 || got[0] != "vi" {
+		t.Errorf("editorCommand() = %q, want vi fallback", got)
+	}
+}
+
+func TestCheckAndSaveDescription_RetriesThenAbortsOnConflict(t *testing.T) {
+	app, q := newOfflineTestApp(t)
+	ui := serializeUpdates(app)
+
+	path := filepath.Join(t.TempDir(), "ENG-1.md")
+	if err := os.WriteFile(path, []byte("Mine\n"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	updatedAt := time.Now().Add(-time.Hour)
+	base := linearapi.Issue{ID: "issue-1", Identifier: "ENG-1", Description: "Original", UpdatedAt: updatedAt}
+
+	// The first check fails; the retry finds a remote edit
+	var fetches atomic.Int32
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		if fetches.Add(1) == 1 {
+			return linearapi.Issue{}, errors.New("connection reset")
+		}
+		return linearapi.Issue{ID: id, Identifier: "ENG-1", Description: "Theirs", UpdatedAt: updatedAt.Add(time.Minute)}, nil
+	}
+
+	app.checkAndSaveDescription(base, "Mine", path)
+	selectPickerItem(t, app, ui, descriptionCheckRetry)
+
+	waitForCondition(t, time.Second, func() bool {
+		ui.Lock()
+		defer ui.Unlock()
+		return len(app.pickerModal.items) > 0 && app.pickerModal.items[0].ID == descriptionConflictMerge
+	})
+	selectPickerItem(t, app, ui, descriptionConflictAbort)
+
+	if fetches.Load() != 2 {
+		t.Errorf("fetches = %d, want 2", fetches.Load())
+	}
+	if pending := q.Pending(); len(pending) != 0 {
+		t.Errorf("pending = %+v, want no description saved", pending)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("edit file removed after abort: %v", err)
+	}
+}
+
+// selectPickerItem chooses the picker item with id, as pressing Enter on it would.
+func selectPickerItem(t *testing.T, app *App, ui *sync.Mutex, id string) {
+	t.Helper()
+	ui.Lock()
+	defer ui.Unlock()
+	for _, item := range app.pickerModal.items {
+		if item.ID == id {
+			app.pickerModal.Hide()
+			app.pickerModal.onSelect(item)
+			return
+		}
+	}
+	t.Fatalf("picker items = %+v, want %s", app.pickerModal.items, id)
+}
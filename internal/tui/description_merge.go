@@ -0,0 +1,130 @@
+package tui
+
+import "strings"
+
+// Conflict markers written into the description when local and remote edits overlap.
+const (
+	mergeMarkerLocal  = "<<<<<<< local"
+	mergeMarkerSep    = "======="
+	mergeMarkerRemote = ">>>>>>> remote"
+)
+
+// mergeDescriptions performs a line-based three-way merge of a description
+// edited locally (local) and remotely (remote) from a common base. Overlapping
+// changes are kept side by side between conflict markers and reported via the
+// second return value.
+func mergeDescriptions(base, local, remote string) (string, bool) {
+	baseLines := splitLines(base)
+	localLines := splitLines(local)
+	remoteLines := splitLines(remote)
+
+	toLocal := matchLines(baseLines, localLines)
+	toRemote := matchLines(baseLines, remoteLines)
+
+	var merged []string
+	conflict := false
+	b, l, r := 0, 0, 0
+	for {
+		// Find the next base line kept unchanged by both sides
+		next, nextLocal, nextRemote := len(baseLines), len(localLines), len(remoteLines)
+		for i := b; i < len(baseLines); i++ {
+			if toLocal[i] >= 0 && toRemote[i] >= 0 {
+				next, nextLocal, nextRemote = i, toLocal[i], toRemote[i]
+				break
+			}
+		}
+
+		baseChunk := baseLines[b:next]
+		localChunk := localLines[l:nextLocal]
+		remoteChunk := remoteLines[r:nextRemote]
+		switch {
+		case equalLines(localChunk, baseChunk):
+			merged = append(merged, remoteChunk...)
+		case equalLines(remoteChunk, baseChunk), equalLines(localChunk, remoteChunk):
+			merged = append(merged, localChunk...)
+		default:
+			conflict = true
+			merged = append(merged, mergeMarkerLocal)
+			merged = append(merged, localChunk...)
+			merged = append(merged, mergeMarkerSep)
+			merged = append(merged, remoteChunk...)
+			merged = append(merged, mergeMarkerRemote)
+		}
+
+		if next == len(baseLines) {
+			break
+		}
+		merged = append(merged, baseLines[next])
+		b, l, r = next+1, nextLocal+1, nextRemote+1
+	}
+
+	return strings.Join(merged, "\n"), conflict
+}
+
+// hasMergeMarkers reports whether text still contains unresolved conflict markers.
+func hasMergeMarkers(text string) bool {
+	for _, line := range splitLines(text) {
+		if line == mergeMarkerLocal || line == mergeMarkerRemote {
+			return true
+		}
+	}
+	return false
+}
+
+// splitLines splits text into lines; empty text has no lines.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// matchLines returns, for each line of a, the index of the matching line of b
+// in their longest common subsequence, or -1 when the line is not kept.
+func matchLines(a, b []string) []int {
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			matches[i] = j
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matches
+}
+
+// equalLines reports whether two line slices are identical.
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package tui
+
+import "testing"
+
+func TestMergeDescriptions(t *testing.T) {
+	base := "# Steps\n1. Open app\n2. Click save\n\nExpected: saved"
+
+	tests := []struct {
+		name         string
+		local        string
+		remote       string
+		want         string
+		wantConflict bool
+	}{
+		{
+			name:   "only local changes",
+			local:  "# Steps\n1. Open app\n2. Click save twice\n\nExpected: saved",
+			remote: base,
+			want:   "# Steps\n1. Open app\n2. Click save twice\n\nExpected: saved",
+		},
+		{
+			name:   "only remote changes",
+			local:  base,
+			remote: base + "\nActual: crash",
+			want:   base + "\nActual: crash",
+		},
+		{
+			name:   "separate changes",
+			local:  "# Steps\n1. Open app\n2. Click save twice\n\nExpected: saved",
+			remote: "# Steps to reproduce\n1. Open app\n2. Click save\n\nExpected: saved",
+			want:   "# Steps to reproduce\n1. Open app\n2. Click save twice\n\nExpected: saved",
+		},
+		{
+			name:   "same change on both sides",
+			local:  "# Steps\n1. Open app\n2. Click save\n\nExpected: saved once",
+			remote: "# Steps\n1. Open app\n2. Click save\n\nExpected: saved once",
+			want:   "# Steps\n1. Open app\n2. Click save\n\nExpected: saved once",
+		},
+		{
+			name:         "overlapping changes",
+			local:        "# Steps\n1. Open app\n2. Click save\n\nExpected: saved locally",
+			remote:       "# Steps\n1. Open app\n2. Click save\n\nExpected: synced",
+			want:         "# Steps\n1. Open app\n2. Click save\n\n<<<<<<< local\nExpected: saved locally\n=======\nExpected: synced\n>>>>>>> remote",
+			wantConflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflict := mergeDescriptions(base, tt.local, tt.remote)
+			if got != tt.want || conflict != tt.wantConflict {
+				t.Errorf("mergeDescriptions() = %q, %v; want %q, %v", got, conflict, tt.want, tt.wantConflict)
+			}
+			if hasMergeMarkers(got) != tt.wantConflict {
+				t.Errorf("hasMergeMarkers() = %v, want %v", hasMergeMarkers(got), tt.wantConflict)
+			}
+		})
+	}
+}
+
+func TestMergeDescriptionsFromEmptyBase(t *testing.T) {
+	got, conflict := mergeDescriptions("", "local text", "remote text")
+	if !conflict || got != "<<<<<<< local\nlocal text\n=======\nremote text\n>>>>>>> remote" {
+		t.Errorf("mergeDescriptions(\"\") = %q, %v; want a conflict", got, conflict)
+	}
+}
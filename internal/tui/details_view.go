@@ -63,6 +63,7 @@ func (a *App) buildDetailsView() *tview.Flex {
 	// Create comments view (bottom section, scrollable, fixed height)
 	a.detailsCommentsView = tview.NewTextView()
 	a.detailsCommentsView.SetDynamicColors(true).
+		SetRegions(true). // Comment headers are regions so the focused comment can be highlighted
 		SetWrap(true).
 		SetWordWrap(true).
 		SetBorder(true).
@@ -158,6 +159,11 @@ func (a *App) updateDetailsView() {
 	}
 	headerLines = append(headerLines, fmt.Sprintf("%sLabels:[-]     %s%s[-]", keyColor, valColor, labelsText))
 
+	// Cycle (if scheduled)
+	if issue.Cycle != nil {
+		headerLines = append(headerLines, fmt.Sprintf("%sCycle:[-]      %s%s[-]", keyColor, valColor, cycleLabel(issue.Cycle.Number, issue.Cycle.Name)))
+	}
+
 	// Parent issue (if this is a sub-issue)
 	if issue.Parent != nil {
 		parentText := fmt.Sprintf("%s - %s", issue.Parent.Identifier, issue.Parent.Title)
@@ -181,6 +187,29 @@ func (a *App) updateDetailsView() {
 		}
 	}
 
+	// Relations (blockers first, since they matter most for planning)
+	if len(issue.Relations) > 0 {
+		grouped := groupRelationsByType(issue.Relations)
+		for _, relType := range relationTypeOrder {
+			relations := grouped[relType]
+			if len(relations) == 0 {
+				continue
+			}
+			for i := 0; i < sectionGap; i++ {
+				headerLines = append(headerLines, "")
+			}
+			headerLines = append(headerLines, fmt.Sprintf("%s%s:[-]", keyColor, relationTypeLabel(relType)))
+			for _, relation := range relations {
+				relationLine := fmt.Sprintf("  %s└─[-] %s%s[-] %s[%s][-] %s%s[-]",
+					keyColor,
+					accentColor, relation.Issue.Identifier,
+					keyColor, relation.Issue.State,
+					valColor, relation.Issue.Title)
+				headerLines = append(headerLines, relationLine)
+			}
+		}
+	}
+
 	for i := 0; i < sectionGap; i++ {
 		headerLines = append(headerLines, "")
 	}
@@ -215,15 +244,21 @@ func (a *App) updateDetailsView() {
 	if len(issue.Comments) > 0 {
 		_, _ = fmt.Fprintf(commentsWriter, "%sComments:[-] (%d)\n\n", keyColor, len(issue.Comments))
 
-		for i, comment := range issue.Comments {
+		rows := threadComments(issue.Comments)
+		focusedFound := false
+		for i, row := range rows {
+			comment := row.Comment
+			focusedFound = focusedFound || comment.ID == a.focusedCommentID
+			indent := strings.Repeat("    ", row.Depth)
+
 			// Comment header: author and timestamp
-			authorDisplay := comment.Author.DisplayName
-			if authorDisplay == "" {
-				authorDisplay = comment.Author.Name
-			}
+			authorDisplay := commentAuthor(comment)
 			if comment.Author.IsMe {
 				authorDisplay = fmt.Sprintf("%s (me)", authorDisplay)
 			}
+			if row.Depth > 0 {
+				authorDisplay = "↳ " + authorDisplay
+			}
 
 			// Format timestamp
 			timeStr := comment.CreatedAt.Format("Jan 2, 2006 3:04 PM")
@@ -231,25 +266,37 @@ func (a *App) updateDetailsView() {
 				timeStr += " (edited)"
 			}
 
-			_, _ = fmt.Fprintf(commentsWriter, "%s%s[-] %s%s[-]\n", accentColor, authorDisplay, keyColor, timeStr)
+			_, _ = fmt.Fprintf(commentsWriter, "%s[\"%s\"]%s%s[-] %s%s[-][\"\"]\n", indent, commentRegionID(comment.ID), accentColor, authorDisplay, keyColor, timeStr)
 			_, _ = fmt.Fprint(commentsWriter, "\n")
 
-			// Render comment body as markdown
+			// Render comment body as markdown, indented under its thread
 			renderedComment := renderMarkdown(comment.Body)
+			if indent != "" {
+				renderedComment = indent + strings.ReplaceAll(renderedComment, "\n", "\n"+indent)
+			}
 			_, _ = fmt.Fprint(commentsWriter, renderedComment)
 
-			// Add separator between comments (but not after the last one)
-			if i < len(issue.Comments)-1 {
+			// Add separator between threads, and a gap between replies
+			if i < len(rows)-1 {
 				_, _ = fmt.Fprint(commentsWriter, "\n\n")
-				_, _ = fmt.Fprintf(commentsWriter, "%s────────────────────────────────────────[-]\n\n", dividerColor)
+				if rows[i+1].Depth == 0 {
+					_, _ = fmt.Fprintf(commentsWriter, "%s────────────────────────────────────────[-]\n\n", dividerColor)
+				}
 			}
 		}
+		if !focusedFound {
+			a.focusedCommentID = ""
+		}
 	} else {
 		// Empty state for comments
+		a.focusedCommentID = ""
 		_, _ = fmt.Fprintf(commentsWriter, "%sNo comments yet.[-]", keyColor)
 	}
 
 	a.detailsCommentsView.ScrollToBeginning()
+	if a.focusedCommentID != "" {
+		a.highlightFocusedComment()
+	}
 	if a.focusedPane == FocusDetails && !a.detailsCommentsVisible {
 		a.updateFocus()
 	}
@@ -0,0 +1,457 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// snoozeItems are the snooze durations offered in the snooze picker.
+var snoozeItems = []PickerItem{
+	{ID: "1h", Label: "1 hour"},
+	{ID: "3h", Label: "3 hours"},
+	{ID: "tomorrow", Label: "Tomorrow morning"},
+	{ID: "next_week", Label: "Next week"},
+}
+
+// snoozeUntil returns when a notification snoozed with the given picker item
+// ID at now reappears. Mornings start at 9:00 local time.
+func snoozeUntil(id string, now time.Time) (time.Time, bool) {
+	morning := func(days int) time.Time {
+		day := now.AddDate(0, 0, days)
+		return time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, now.Location())
+	}
+	switch id {
+	case "1h":
+		return now.Add(time.Hour), true
+	case "3h":
+		return now.Add(3 * time.Hour), true
+	case "tomorrow":
+		return morning(1), true
+	case "next_week":
+		days := (8 - int(now.Weekday())) % 7
+		if days == 0 {
+			days = 7
+		}
+		return morning(days), true
+	}
+	return time.Time{}, false
+}
+
+// activeNotifications returns the notifications that are not snoozed at now.
+func activeNotifications(notifications []linearapi.Notification, now time.Time) []linearapi.Notification {
+	active := make([]linearapi.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		if notification.SnoozedUntil.After(now) {
+			continue
+		}
+		active = append(active, notification)
+	}
+	return active
+}
+
+// unreadNotificationCount counts unread notifications that are not snoozed at now.
+func unreadNotificationCount(notifications []linearapi.Notification, now time.Time) int {
+	count := 0
+	for _, notification := range activeNotifications(notifications, now) {
+		if notification.Unread() {
+			count++
+		}
+	}
+	return count
+}
+
+// inboxLabel returns the navigation label for the inbox.
+func inboxLabel(unread int) string {
+	if unread == 0 {
+		return "Inbox"
+	}
+	return fmt.Sprintf("Inbox (%d)", unread)
+}
+
+// notificationReason describes why a notification was sent, e.g.
+// "issueStatusChanged" becomes "status changed".
+func notificationReason(notificationType string) string {
+	switch notificationType {
+	case "issueMention":
+		return "mentioned you"
+	case "issueCommentMention":
+		return "mentioned you in a comment"
+	}
+
+	var words []string
+	var word strings.Builder
+	for _, r := range strings.TrimPrefix(notificationType, "issue") {
+		if unicode.IsUpper(r) && word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+		word.WriteRune(unicode.ToLower(r))
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return strings.Join(words, " ")
+}
+
+// InboxModal lists Linear notifications and acts on the selected one.
+type InboxModal struct {
+	app           *App
+	modal         *tview.Flex
+	table         *tview.Table
+	notifications []linearapi.Notification // Rows shown in the table
+}
+
+// NewInboxModal creates a new inbox modal.
+func NewInboxModal(app *App) *InboxModal {
+	im := &InboxModal{
+		app: app,
+	}
+
+	im.table = tview.NewTable().
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	im.table.SetBackgroundColor(app.theme.HeaderBg)
+	im.table.SetSelectedStyle(tcell.StyleDefault.
+		Background(app.theme.Accent).
+		Foreground(app.theme.SelectionText))
+
+	// Create help text
+	helpView := tview.NewTextView()
+	helpView.SetText("Enter: open issue • r: read/unread • s: snooze • a: archive • R: reload • Esc: close")
+	helpView.SetTextColor(app.theme.SecondaryText)
+	helpView.SetBackgroundColor(app.theme.HeaderBg)
+	helpView.SetTextAlign(tview.AlignCenter)
+
+	// Build modal content
+	modalContent := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(im.table, 0, 1, true).
+		AddItem(helpView, 1, 0, false)
+	modalContent.Box = tview.NewBox().SetBackgroundColor(app.theme.HeaderBg)
+	modalContent.SetBackgroundColor(app.theme.HeaderBg).
+		SetBorder(true).
+		SetBorderColor(app.theme.Accent).
+		SetTitle(" Inbox ").
+		SetTitleColor(app.theme.Foreground)
+	padding := app.density.ModalPadding
+	modalContent.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
+
+	// Center the modal on screen
+	im.modal = tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().
+			SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(modalContent, 0, 4, true).
+			AddItem(nil, 0, 1, false), 0, 6, true).
+		AddItem(nil, 0, 1, false)
+	im.modal.SetBackgroundColor(app.theme.Background)
+
+	return im
+}
+
+// Show displays the inbox modal.
+func (im *InboxModal) Show() {
+	im.Render(im.app.notifications)
+	im.app.pages.AddPage("inbox", im.modal, true, true)
+	im.app.pages.SendToFront("inbox")
+	im.app.app.SetFocus(im.table)
+}
+
+// Hide hides the inbox modal.
+func (im *InboxModal) Hide() {
+	im.app.pages.RemovePage("inbox")
+	im.app.updateFocus()
+}
+
+// Render shows notifications that are not snoozed, keeping the selected row.
+func (im *InboxModal) Render(notifications []linearapi.Notification) {
+	selectedID := ""
+	if notification, ok := im.Selected(); ok {
+		selectedID = notification.ID
+	}
+	im.notifications = activeNotifications(notifications, time.Now())
+
+	theme := im.app.theme
+	im.table.Clear()
+	headerStyle := tcell.StyleDefault.
+		Foreground(theme.HeaderText).
+		Background(theme.HeaderBg).
+		Bold(true)
+	for col, header := range []string{"", "Issue", "Reason", "Title", "From", "When"} {
+		im.table.SetCell(0, col, tview.NewTableCell(header).
+			SetStyle(headerStyle).
+			SetSelectable(false))
+	}
+
+	if len(im.notifications) == 0 {
+		im.table.SetCell(1, 3, tview.NewTableCell("No notifications").
+			SetTextColor(theme.SecondaryText).
+			SetSelectable(false))
+		return
+	}
+
+	selectedRow := 1
+	for i, notification := range im.notifications {
+		row := i + 1
+		color := theme.SecondaryText
+		marker := " "
+		if notification.Unread() {
+			color = theme.Foreground
+			marker = "●"
+		}
+		cells := []*tview.TableCell{
+			tview.NewTableCell(marker).SetTextColor(theme.Accent),
+			tview.NewTableCell(notification.IssueIdentifier).SetTextColor(color),
+			tview.NewTableCell(notificationReason(notification.Type)).SetTextColor(color),
+			tview.NewTableCell(tview.Escape(notification.IssueTitle)).SetTextColor(color).SetExpansion(1).SetMaxWidth(60),
+			tview.NewTableCell(tview.Escape(notification.Actor)).SetTextColor(color),
+			tview.NewTableCell(notification.CreatedAt.Local().Format("Jan 2, 3:04 PM")).SetTextColor(color),
+		}
+		for col, cell := range cells {
+			im.table.SetCell(row, col, cell.SetBackgroundColor(theme.HeaderBg))
+		}
+		if notification.ID == selectedID {
+			selectedRow = row
+		}
+	}
+	im.table.Select(selectedRow, 0)
+}
+
+// Selected returns the notification in the selected row.
+func (im *InboxModal) Selected() (linearapi.Notification, bool) {
+	row, _ := im.table.GetSelection()
+	if row < 1 || row > len(im.notifications) {
+		return linearapi.Notification{}, false
+	}
+	return im.notifications[row-1], true
+}
+
+// HandleKey handles keyboard input for the inbox modal.
+func (im *InboxModal) HandleKey(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		im.Hide()
+		return nil
+	case tcell.KeyEnter:
+		if notification, ok := im.Selected(); ok {
+			im.Hide()
+			im.app.openNotification(notification)
+		}
+		return nil
+	case tcell.KeyRune:
+		notification, ok := im.Selected()
+		switch event.Rune() {
+		case 'r':
+			if ok {
+				im.app.markNotificationsRead([]linearapi.Notification{notification}, notification.Unread())
+			}
+			return nil
+		case 's':
+			if ok {
+				im.app.showSnoozePicker([]linearapi.Notification{notification})
+			}
+			return nil
+		case 'a':
+			if ok {
+				im.app.archiveNotifications([]linearapi.Notification{notification})
+			}
+			return nil
+		case 'R':
+			im.app.loadNotifications()
+			return nil
+		}
+	}
+	return event
+}
+
+// ShowInbox opens the inbox and reloads notifications.
+func (a *App) ShowInbox() {
+	a.inboxModal.Show()
+	a.loadNotifications()
+}
+
+// loadNotifications fetches notifications in the background and updates the
+// inbox and its unread count.
+func (a *App) loadNotifications() {
+	go func() {
+		notifications, err := a.GetAPI().ListNotifications(context.Background())
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.app: failed to load notifications")
+				if a.pages.HasPage("inbox") {
+					a.updateStatusBarWithError(err)
+				}
+				return
+			}
+			logger.Debug("tui.app: loaded notifications count=%d", len(notifications))
+			a.notifications = notifications
+			a.renderNotifications()
+		})
+	}()
+}
+
+// renderNotifications updates the inbox node's unread count and the open inbox.
+func (a *App) renderNotifications() {
+	if a.inboxNode != nil {
+		a.inboxNode.SetText(inboxLabel(unreadNotificationCount(a.notifications, time.Now())))
+	}
+	if a.pages.HasPage("inbox") {
+		a.inboxModal.Render(a.notifications)
+	}
+}
+
+// commandNotifications returns the notifications palette commands act on: the
+// row selected in the inbox, or otherwise those about the selected issue.
+func (a *App) commandNotifications() []linearapi.Notification {
+	if a.pages.HasPage("inbox") {
+		if notification, ok := a.inboxModal.Selected(); ok {
+			return []linearapi.Notification{notification}
+		}
+		return nil
+	}
+
+	issue := a.GetSelectedIssue()
+	if issue == nil {
+		return nil
+	}
+	var notifications []linearapi.Notification
+	for _, notification := range activeNotifications(a.notifications, time.Now()) {
+		if notification.IssueID == issue.ID {
+			notifications = append(notifications, notification)
+		}
+	}
+	return notifications
+}
+
+// runNotificationCommand runs action on the command notifications, reporting
+// when there are none.
+func (a *App) runNotificationCommand(action func(notifications []linearapi.Notification)) {
+	notifications := a.commandNotifications()
+	if len(notifications) == 0 {
+		a.updateStatusBarWithError(fmt.Errorf("no notifications for the selected issue"))
+		return
+	}
+	action(notifications)
+}
+
+// changeNotifications applies a change to notifications locally (keep returns
+// false to drop a notification from the inbox), then sends it to Linear.
+// Notifications are reloaded if the API call fails.
+func (a *App) changeNotifications(targets []linearapi.Notification, action string, keep func(*linearapi.Notification) bool, send func(ctx context.Context, id string) error) {
+	ids := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		ids[target.ID] = true
+	}
+	notifications := make([]linearapi.Notification, 0, len(a.notifications))
+	for _, notification := range a.notifications {
+		if ids[notification.ID] && !keep(&notification) {
+			continue
+		}
+		notifications = append(notifications, notification)
+	}
+	a.notifications = notifications
+	a.renderNotifications()
+
+	go func() {
+		ctx := context.Background()
+		var err error
+		for id := range ids {
+			if err = send(ctx, id); err != nil {
+				break
+			}
+		}
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.commands: failed to %s notifications count=%d", action, len(ids))
+				a.updateStatusBarWithError(err)
+				a.loadNotifications()
+				return
+			}
+			logger.Info("tui.commands: %s notifications count=%d", action, len(ids))
+		})
+	}()
+}
+
+// markNotificationsRead marks notifications read, or unread when read is false.
+func (a *App) markNotificationsRead(notifications []linearapi.Notification, read bool) {
+	action := "mark unread"
+	if read {
+		action = "mark read"
+	}
+	now := time.Now()
+	a.changeNotifications(notifications, action, func(notification *linearapi.Notification) bool {
+		notification.ReadAt = time.Time{}
+		if read {
+			notification.ReadAt = now
+		}
+		return true
+	}, func(ctx context.Context, id string) error {
+		return a.GetAPI().MarkNotificationRead(ctx, id, read)
+	})
+}
+
+// showSnoozePicker asks how long to snooze notifications for.
+func (a *App) showSnoozePicker(notifications []linearapi.Notification) {
+	a.pickerActive = true
+	a.pickerModal.Show("Snooze until", snoozeItems, func(item PickerItem) {
+		until, ok := snoozeUntil(item.ID, time.Now())
+		if !ok {
+			return
+		}
+		a.changeNotifications(notifications, "snooze", func(notification *linearapi.Notification) bool {
+			notification.SnoozedUntil = until
+			return true
+		}, func(ctx context.Context, id string) error {
+			return a.GetAPI().SnoozeNotification(ctx, id, until)
+		})
+	})
+}
+
+// archiveNotifications archives notifications, removing them from the inbox.
+func (a *App) archiveNotifications(notifications []linearapi.Notification) {
+	a.changeNotifications(notifications, "archive", func(*linearapi.Notification) bool {
+		return false
+	}, a.GetAPI().ArchiveNotification)
+}
+
+// openNotification marks a notification read and selects its issue.
+func (a *App) openNotification(notification linearapi.Notification) {
+	if notification.Unread() {
+		a.markNotificationsRead([]linearapi.Notification{notification}, true)
+	}
+	a.openIssue(notification.IssueID, notification.IssueIdentifier)
+}
+
+// openIssue selects an issue in the issues pane. Issues that are not in the
+// current list are looked up by searching All Issues for their identifier.
+func (a *App) openIssue(issueID, identifier string) {
+	a.focusedPane = FocusIssues
+	a.updateFocus()
+
+	if _, ok := a.idToIssue[issueID]; ok {
+		if issue := a.rebuildIssuesTables(issueID); issue != nil {
+			a.onIssueSelected(*issue)
+		}
+		return
+	}
+
+	root := a.navigationTree.GetRoot()
+	for _, child := range root.GetChildren() {
+		if navNode, ok := child.GetReference().(*NavigationNode); ok && navNode.ID == "all" {
+			a.navigationTree.SetCurrentNode(child)
+			a.selectedNavigation = navNode
+			break
+		}
+	}
+	a.searchQuery = identifier
+	go a.refreshIssues(issueID)
+}
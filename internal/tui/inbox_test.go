@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestSnoozeUntil(t *testing.T) {
+	// Wednesday afternoon
+	now := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		id   string
+		want time.Time
+	}{
+		{id: "1h", want: time.Date(2025, 1, 15, 15, 30, 0, 0, time.UTC)},
+		{id: "3h", want: time.Date(2025, 1, 15, 17, 30, 0, 0, time.UTC)},
+		{id: "tomorrow", want: time.Date(2025, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{id: "next_week", want: time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, ok := snoozeUntil(tt.id, now)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("snoozeUntil(%q) = %v, %v; want %v", tt.id, got, ok, tt.want)
+		}
+	}
+
+	// On a Monday, next week is the following Monday
+	monday := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+	if got, _ := snoozeUntil("next_week", monday); !got.Equal(time.Date(2025, 1, 27, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("snoozeUntil(next_week) on Monday = %v", got)
+	}
+
+	if _, ok := snoozeUntil("unknown", now); ok {
+		t.Error("snoozeUntil(unknown) ok = true, want false")
+	}
+}
+
+func TestUnreadNotificationCount(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	notifications := []linearapi.Notification{
+		{ID: "unread"},
+		{ID: "read", ReadAt: now.Add(-time.Hour)},
+		{ID: "snoozed", SnoozedUntil: now.Add(time.Hour)},
+		{ID: "snooze-over", SnoozedUntil: now.Add(-time.Hour)},
+	}
+
+	active := activeNotifications(notifications, now)
+	if len(active) != 3 || active[2].ID != "snooze-over" {
+		t.Errorf("activeNotifications() = %+v, want snoozed notification hidden", active)
+	}
+	if got := unreadNotificationCount(notifications, now); got != 2 {
+		t.Errorf("unreadNotificationCount() = %d, want 2", got)
+	}
+	if got := inboxLabel(2); got != "Inbox (2)" {
+		t.Errorf("inboxLabel(2) = %q", got)
+	}
+	if got := inboxLabel(0); got != "Inbox" {
+		t.Errorf("inboxLabel(0) = %q", got)
+	}
+}
+
+func TestNotificationReason(t *testing.T) {
+	tests := map[string]string{
+		"issueAssignedToYou":  "assigned to you",
+		"issueStatusChanged":  "status changed",
+		"issueNewComment":     "new comment",
+		"issueMention":        "mentioned you",
+		"issueCommentMention": "mentioned you in a comment",
+	}
+	for notificationType, want := range tests {
+		if got := notificationReason(notificationType); got != want {
+			t.Errorf("notificationReason(%q) = %q, want %q", notificationType, got, want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// relationTypeOrder is the order relation sections are shown in the details view
+// and offered in the relation type picker.
+var relationTypeOrder = []linearapi.RelationType{
+	linearapi.RelationBlockedBy,
+	linearapi.RelationBlocks,
+	linearapi.RelationRelated,
+	linearapi.RelationDuplicate,
+	linearapi.RelationDuplicatedBy,
+}
+
+// relationTypeLabel returns the display label for a relation type.
+func relationTypeLabel(relType linearapi.RelationType) string {
+	switch relType {
+	case linearapi.RelationBlocks:
+		return "Blocks"
+	case linearapi.RelationBlockedBy:
+		return "Blocked by"
+	case linearapi.RelationRelated:
+		return "Related"
+	case linearapi.RelationDuplicate:
+		return "Duplicate of"
+	case linearapi.RelationDuplicatedBy:
+		return "Duplicated by"
+	default:
+		return string(relType)
+	}
+}
+
+// groupRelationsByType groups relations by type, preserving API order within each group.
+func groupRelationsByType(relations []linearapi.IssueRelation) map[linearapi.RelationType][]linearapi.IssueRelation {
+	grouped := make(map[linearapi.RelationType][]linearapi.IssueRelation)
+	for _, relation := range relations {
+		grouped[relation.Type] = append(grouped[relation.Type], relation)
+	}
+	return grouped
+}
@@ -0,0 +1,43 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestRelationTypeLabel(t *testing.T) {
+	tests := map[linearapi.RelationType]string{
+		linearapi.RelationBlocks:       "Blocks",
+		linearapi.RelationBlockedBy:    "Blocked by",
+		linearapi.RelationRelated:      "Related",
+		linearapi.RelationDuplicate:    "Duplicate of",
+		linearapi.RelationDuplicatedBy: "Duplicated by",
+	}
+	for relType, want := range tests {
+		if got := relationTypeLabel(relType); got != want {
+			t.Errorf("relationTypeLabel(%q) = %q, want %q", relType, got, want)
+		}
+	}
+}
+
+func TestGroupRelationsByType(t *testing.T) {
+	relations := []linearapi.IssueRelation{
+		{ID: "rel-1", Type: linearapi.RelationBlocks},
+		{ID: "rel-2", Type: linearapi.RelationBlockedBy},
+		{ID: "rel-3", Type: linearapi.RelationBlocks},
+	}
+
+	grouped := groupRelationsByType(relations)
+
+	blocks := grouped[linearapi.RelationBlocks]
+	if len(blocks) != 2 || blocks[0].ID != "rel-1" || blocks[1].ID != "rel-3" {
+		t.Errorf("blocks group = %+v, want rel-1 and rel-3 in order", blocks)
+	}
+	if len(grouped[linearapi.RelationBlockedBy]) != 1 {
+		t.Errorf("blocked by group = %+v, want 1 relation", grouped[linearapi.RelationBlockedBy])
+	}
+	if len(grouped[linearapi.RelationRelated]) != 0 {
+		t.Errorf("related group = %+v, want empty", grouped[linearapi.RelationRelated])
+	}
+}
@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// rowsForSection returns the issue rows rendered in a section.
+func (a *App) rowsForSection(section IssuesSection) []IssueRow {
+	if section == IssuesSectionMy {
+		return a.myIssueRows
+	}
+	return a.otherIssueRows
+}
+
+// tableForSection returns the issues table for a section.
+func (a *App) tableForSection(section IssuesSection) *tview.Table {
+	if section == IssuesSectionMy {
+		return a.myIssuesTable
+	}
+	return a.otherIssuesTable
+}
+
+// toggleIssueMark marks or unmarks an issue for bulk operations.
+func (a *App) toggleIssueMark(issueID string) {
+	if a.markedIssueIDs[issueID] {
+		delete(a.markedIssueIDs, issueID)
+	} else {
+		a.markedIssueIDs[issueID] = true
+	}
+	a.bulkStatus = ""
+	a.renderIssueMarks()
+}
+
+// markAllInSection marks every visible issue in a section. If all of them are
+// already marked, they are unmarked instead.
+func (a *App) markAllInSection(section IssuesSection) {
+	rows := a.rowsForSection(section)
+	allMarked := len(rows) > 0
+	for _, row := range rows {
+		if !a.markedIssueIDs[row.IssueID] {
+			allMarked = false
+			break
+		}
+	}
+	for _, row := range rows {
+		if allMarked {
+			delete(a.markedIssueIDs, row.IssueID)
+		} else {
+			a.markedIssueIDs[row.IssueID] = true
+		}
+	}
+	a.bulkStatus = ""
+	a.renderIssueMarks()
+}
+
+// clearIssueMarks unmarks all issues and leaves visual mode.
+// It returns false if there was nothing to clear.
+func (a *App) clearIssueMarks() bool {
+	if len(a.markedIssueIDs) == 0 && a.visualAnchorID == "" {
+		return false
+	}
+	a.markedIssueIDs = make(map[string]bool)
+	a.visualAnchorID = ""
+	a.visualBaseMarked = nil
+	a.renderIssueMarks()
+	return true
+}
+
+// toggleVisualMode starts range selection at issueID, or ends it and keeps the
+// marked range.
+func (a *App) toggleVisualMode(issueID string, section IssuesSection) {
+	if a.visualAnchorID != "" {
+		a.visualAnchorID = ""
+		a.visualBaseMarked = nil
+		a.updateStatusBar()
+		return
+	}
+	a.visualAnchorID = issueID
+	a.visualSection = section
+	a.visualBaseMarked = make(map[string]bool, len(a.markedIssueIDs))
+	for id := range a.markedIssueIDs {
+		a.visualBaseMarked[id] = true
+	}
+	a.bulkStatus = ""
+	a.extendVisualSelection(issueID, section)
+}
+
+// extendVisualSelection marks the range between the visual anchor and issueID,
+// on top of the marks that existed when visual mode started.
+func (a *App) extendVisualSelection(issueID string, section IssuesSection) {
+	if a.visualAnchorID == "" || section != a.visualSection {
+		return
+	}
+	marked := make(map[string]bool, len(a.visualBaseMarked))
+	for id := range a.visualBaseMarked {
+		marked[id] = true
+	}
+	for _, id := range issueIDsInRange(a.rowsForSection(section), a.visualAnchorID, issueID) {
+		marked[id] = true
+	}
+	a.markedIssueIDs = marked
+	a.renderIssueMarks()
+}
+
+// renderIssueMarks re-renders both tables and the board to show the current marks.
+func (a *App) renderIssueMarks() {
+	if a.isBoardLayout() {
+		a.renderIssuesBoard()
+	}
+	if a.myIssuesTable != nil {
+		renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, a.selectedIssueID(IssuesSectionMy), a.markedIssueIDs, a.changedIssueIDs, a.theme)
+	}
+	if a.otherIssuesTable != nil {
+		renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, a.selectedIssueID(IssuesSectionOther), a.markedIssueIDs, a.changedIssueIDs, a.theme)
+	}
+	a.updateStatusBar()
+}
+
+// markedIssues returns the marked issues that are still in the list, in list order.
+func (a *App) markedIssues() []linearapi.Issue {
+	if len(a.markedIssueIDs) == 0 {
+		return nil
+	}
+	a.issuesMu.RLock()
+	defer a.issuesMu.RUnlock()
+	marked := make([]linearapi.Issue, 0, len(a.markedIssueIDs))
+	for _, issue := range a.issues {
+		if a.markedIssueIDs[issue.ID] {
+			marked = append(marked, issue)
+		}
+	}
+	return marked
+}
+
+// issueIDsInRange returns the IDs of the rows between two issues, inclusive.
+// It returns nil if either issue is not in rows.
+func issueIDsInRange(rows []IssueRow, fromID, toID string) []string {
+	from, to := getRowForIssueModel(fromID, rows), getRowForIssueModel(toID, rows)
+	if from < 0 || to < 0 {
+		return nil
+	}
+	if from > to {
+		from, to = to, from
+	}
+	ids := make([]string, 0, to-from+1)
+	for _, row := range rows[from-1 : to] {
+		ids = append(ids, row.IssueID)
+	}
+	return ids
+}
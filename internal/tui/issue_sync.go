@@ -0,0 +1,134 @@
+package tui
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/cache"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// issuePageFetcher fetches one page of issues (see linearapi.Client.FetchIssuesPage).
+type issuePageFetcher func(context.Context, linearapi.FetchIssuesParams, *string) (linearapi.IssuePage, error)
+
+// SetIssueStore enables the on-disk issue cache and restores persisted team
+// metadata into the in-memory cache. It should be called before Run.
+func (a *App) SetIssueStore(store *cache.DiskStore) {
+	a.servicesMu.Lock()
+	a.issueStore = store
+	teamCache := a.cache
+	a.servicesMu.Unlock()
+	if store != nil {
+		teamCache.Restore(store.Metadata())
+	}
+}
+
+// syncIssuesFromSnapshot renders the stored snapshot for params immediately and
+// then fetches only issues updated since the snapshot watermark. It returns false
+// when there is no usable snapshot, in which case the caller does a full fetch.
+func (a *App) syncIssuesFromSnapshot(
+	ctx context.Context,
+	services accountServices,
+	params linearapi.FetchIssuesParams,
+	generation int64,
+	targetIssueID string,
+	allowFocus bool,
+) bool {
+	if services.issueStore == nil || !cache.Cacheable(params) {
+		return false
+	}
+	key := cache.IssueScopeKey(params)
+	snapshot, ok := services.issueStore.Snapshot(key)
+	syncStart := time.Now()
+	if !ok || snapshot.NeedsFullSync(syncStart) {
+		return false
+	}
+
+	logger.Debug("tui.app: rendering cached issues scope=%s count=%d watermark=%s", key, len(snapshot.Issues), snapshot.Watermark.Format(time.RFC3339))
+	a.QueueUpdateDraw(func() {
+		a.updateIssuesData(a.snapshotIssuesForDisplay(snapshot.Issues), targetIssueID)
+		if allowFocus {
+			a.focusedPane = FocusIssues
+			a.updateFocus()
+		}
+		a.statusBar.SetText(a.themeTags.Warning + "Syncing changes...[-]")
+	})
+
+	deltaParams := cache.DeltaParams(params, snapshot.Watermark)
+	var delta []linearapi.Issue
+	var after *string
+	for {
+		page, err := services.fetchIssuesPage(ctx, deltaParams, after)
+		if err != nil {
+			a.QueueUpdateDraw(func() {
+				a.isLoading = false
+				logger.ErrorWithErr(err, "tui.app: failed to sync issue changes scope=%s", key)
+				a.updateStatusBarWithError(err)
+				a.runQueuedIssuesRefresh()
+			})
+			return true
+		}
+		if generation != a.refreshGeneration.Load() {
+			a.QueueUpdateDraw(func() {
+				a.isLoading = false
+				a.runQueuedIssuesRefresh()
+			})
+			return true
+		}
+		delta = append(delta, page.Issues...)
+		if !page.HasNext {
+			break
+		}
+		after = page.EndCursor
+	}
+
+	merged := cache.MergeIssueDelta(snapshot, delta, params, syncStart)
+	a.saveIssueSnapshot(services, key, merged)
+
+	a.QueueUpdateDraw(func() {
+		if len(delta) > 0 {
+			a.updateIssuesData(a.snapshotIssuesForDisplay(merged.Issues), targetIssueID)
+		}
+		a.isLoading = false
+		logger.Debug("tui.app: delta sync completed scope=%s changed=%d total=%d", key, len(delta), len(merged.Issues))
+		a.updateStatusBar()
+		a.runQueuedIssuesRefresh()
+	})
+	return true
+}
+
+// storeFullSync saves the result of a complete fetch as the snapshot for params.
+func (a *App) storeFullSync(services accountServices, params linearapi.FetchIssuesParams, issues []linearapi.Issue, syncStart time.Time) {
+	if services.issueStore == nil || !cache.Cacheable(params) {
+		return
+	}
+	a.saveIssueSnapshot(services, cache.IssueScopeKey(params), cache.NewIssueSnapshot(issues, syncStart))
+}
+
+// saveIssueSnapshot records a snapshot and the team metadata of services, then
+// writes the store to disk in the background.
+func (a *App) saveIssueSnapshot(services accountServices, key string, snapshot cache.IssueSnapshot) {
+	store := services.issueStore
+	store.PutSnapshot(key, snapshot)
+	store.PutMetadata(services.cache.Export())
+	go func() {
+		if err := store.Save(); err != nil {
+			logger.Warning("tui.app: failed to save issue cache error=%v", err)
+		}
+	}()
+}
+
+// snapshotIssuesForDisplay returns a copy of snapshot issues in the current sort order.
+// Snapshots are stored newest-updated first; priority sorting is applied by updateIssuesData.
+func (a *App) snapshotIssuesForDisplay(issues []linearapi.Issue) []linearapi.Issue {
+	display := make([]linearapi.Issue, len(issues))
+	copy(display, issues)
+	if a.sortField == SortByCreatedAt {
+		sort.SliceStable(display, func(i, j int) bool {
+			return display[i].CreatedAt.After(display[j].CreatedAt)
+		})
+	}
+	return display
+}
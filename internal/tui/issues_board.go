@@ -0,0 +1,423 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// boardCardWidth is the maximum width of a card title on the board.
+const boardCardWidth = 32
+
+// boardColumn is one column of the board: a workflow state and its issues.
+type boardColumn struct {
+	StateID string // Empty for issues whose state is not one of the team's workflow states
+	TeamID  string
+	Name    string
+	Issues  []linearapi.Issue
+}
+
+// buildBoardColumns groups issues into one column per workflow state, ordered by
+// the state's position. Issues in states that are not in states (for example from
+// another team) get extra columns named after their state, after the known ones.
+func buildBoardColumns(states []linearapi.WorkflowState, issues []linearapi.Issue) []boardColumn {
+	sorted := make([]linearapi.WorkflowState, len(states))
+	copy(sorted, states)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	columns := make([]boardColumn, 0, len(sorted))
+	byStateID := make(map[string]int, len(sorted))
+	for _, state := range sorted {
+		byStateID[state.ID] = len(columns)
+		columns = append(columns, boardColumn{StateID: state.ID, TeamID: state.TeamID, Name: state.Name})
+	}
+
+	byStateName := make(map[string]int)
+	for _, issue := range issues {
+		if i, ok := byStateID[issue.StateID]; ok {
+			columns[i].Issues = append(columns[i].Issues, issue)
+			continue
+		}
+		i, ok := byStateName[issue.State]
+		if !ok {
+			i = len(columns)
+			byStateName[issue.State] = i
+			columns = append(columns, boardColumn{Name: issue.State})
+		}
+		columns[i].Issues = append(columns[i].Issues, issue)
+	}
+	return columns
+}
+
+// findBoardCard returns the column and row of an issue on the board, or -1, -1.
+func findBoardCard(columns []boardColumn, issueID string) (int, int) {
+	for col, column := range columns {
+		for row, issue := range column.Issues {
+			if issue.ID == issueID {
+				return col, row
+			}
+		}
+	}
+	return -1, -1
+}
+
+// isBoardLayout reports whether issues are shown as a board instead of tables.
+func (a *App) isBoardLayout() bool {
+	return a.config.IssuesLayout == config.IssuesLayoutBoard
+}
+
+// buildIssuesBoard creates the board view. Cards are table cells: one column per
+// workflow state, one row per issue below the header.
+func (a *App) buildIssuesBoard() *tview.Table {
+	board := tview.NewTable()
+	board.SetBorders(false).
+		SetSelectable(true, true).
+		SetBorder(true).
+		SetTitle(" Board ").
+		SetTitleColor(a.theme.Foreground).
+		SetBorderColor(a.theme.Border).
+		SetBackgroundColor(a.theme.Background)
+	board.SetSelectedStyle(tcell.StyleDefault.
+		Foreground(a.theme.SelectionText).
+		Background(a.theme.SelectionBg).
+		Bold(true))
+	board.SetFixed(1, 0)
+
+	// Keyboard moves update the cursor before selecting, so this only acts on mouse clicks
+	board.SetSelectionChangedFunc(func(row, col int) {
+		if row-1 == a.boardCursorRow && col == a.boardCursorCol {
+			return
+		}
+		if issue := a.boardCard(col, row-1); issue != nil {
+			a.boardCursorCol, a.boardCursorRow = col, row-1
+			a.onIssueSelected(*issue)
+		}
+	})
+	return board
+}
+
+// boardCard returns the issue at a board position, or nil.
+func (a *App) boardCard(col, row int) *linearapi.Issue {
+	if col < 0 || col >= len(a.boardColumns) {
+		return nil
+	}
+	issues := a.boardColumns[col].Issues
+	if row < 0 || row >= len(issues) {
+		return nil
+	}
+	return &issues[row]
+}
+
+// rebuildIssuesBoard groups the current issues into columns and renders the
+// board, returning the issue under the cursor.
+func (a *App) rebuildIssuesBoard(targetIssueID string) *linearapi.Issue {
+	a.issuesMu.RLock()
+	issues := a.issues
+	a.issuesMu.RUnlock()
+
+	a.boardColumns = buildBoardColumns(a.workflowStates, issues)
+	if col, row := findBoardCard(a.boardColumns, targetIssueID); col >= 0 {
+		a.boardCursorCol, a.boardCursorRow = col, row
+	}
+	a.clampBoardCursor()
+	a.renderIssuesBoard()
+	a.ensureBoardStates()
+	return a.boardCard(a.boardCursorCol, a.boardCursorRow)
+}
+
+// clampBoardCursor keeps the cursor on a card, preferring the nearest
+// non-empty column when the current one has none.
+func (a *App) clampBoardCursor() {
+	if a.boardCursorCol >= len(a.boardColumns) {
+		a.boardCursorCol = len(a.boardColumns) - 1
+	}
+	if a.boardCursorCol < 0 {
+		a.boardCursorCol = 0
+	}
+	if len(a.boardColumns) > 0 && len(a.boardColumns[a.boardCursorCol].Issues) == 0 {
+		for offset := 1; offset < len(a.boardColumns); offset++ {
+			if col := a.boardCursorCol - offset; col >= 0 && len(a.boardColumns[col].Issues) > 0 {
+				a.boardCursorCol = col
+				break
+			}
+			if col := a.boardCursorCol + offset; col < len(a.boardColumns) && len(a.boardColumns[col].Issues) > 0 {
+				a.boardCursorCol = col
+				break
+			}
+		}
+	}
+	rows := 0
+	if a.boardCursorCol < len(a.boardColumns) {
+		rows = len(a.boardColumns[a.boardCursorCol].Issues)
+	}
+	if a.boardCursorRow >= rows {
+		a.boardCursorRow = rows - 1
+	}
+	if a.boardCursorRow < 0 {
+		a.boardCursorRow = 0
+	}
+}
+
+// renderIssuesBoard draws the board columns and selects the card under the cursor.
+func (a *App) renderIssuesBoard() {
+	board := a.issuesBoard
+	if board == nil {
+		return
+	}
+	board.Clear()
+
+	headerStyle := tcell.StyleDefault.
+		Foreground(a.theme.HeaderText).
+		Background(a.theme.HeaderBg).
+		Bold(true)
+
+	maxRows := 0
+	for _, column := range a.boardColumns {
+		if len(column.Issues) > maxRows {
+			maxRows = len(column.Issues)
+		}
+	}
+
+	for col, column := range a.boardColumns {
+		board.SetCell(0, col, tview.NewTableCell(fmt.Sprintf(" %s (%d)", column.Name, len(column.Issues))).
+			SetStyle(headerStyle).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false).
+			SetExpansion(1))
+
+		for row := 0; row < maxRows; row++ {
+			if row >= len(column.Issues) {
+				board.SetCell(row+1, col, tview.NewTableCell("").SetSelectable(false))
+				continue
+			}
+			issue := column.Issues[row]
+			title := []rune(issue.Title)
+			if len(title) > boardCardWidth {
+				title = append(title[:boardCardWidth-1], '…')
+			}
+			identifierColor := a.themeTags.SecondaryText
+			if a.markedIssueIDs[issue.ID] {
+				identifierColor = a.themeTags.Accent + IconMarked
+			}
+			_, priorityColor := formatPriority(issue.Priority, a.theme)
+			board.SetCell(row+1, col, tview.NewTableCell(fmt.Sprintf(" %s%s[-] %s", identifierColor, issue.Identifier, string(title))).
+				SetTextColor(priorityColor).
+				SetAlign(tview.AlignLeft).
+				SetExpansion(1))
+		}
+	}
+
+	if a.boardCard(a.boardCursorCol, a.boardCursorRow) != nil {
+		board.Select(a.boardCursorRow+1, a.boardCursorCol)
+	}
+}
+
+// ensureBoardStates loads the selected team's workflow states in the background
+// so that the board can show a column for every state, including empty ones.
+func (a *App) ensureBoardStates() {
+	if len(a.workflowStates) > 0 {
+		return
+	}
+	teamID := a.GetSelectedTeamID()
+	if teamID == "" || !a.boardStatesLoading.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer a.boardStatesLoading.Store(false)
+		states, err := a.GetCache().GetWorkflowStates(context.Background(), teamID)
+		if err != nil {
+			logger.ErrorWithErr(err, "tui.app: failed to load workflow states for board team_id=%s", teamID)
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			if len(a.workflowStates) > 0 || !a.isBoardLayout() {
+				return
+			}
+			a.workflowStates = states
+			current := ""
+			if issue := a.boardCard(a.boardCursorCol, a.boardCursorRow); issue != nil {
+				current = issue.ID
+			}
+			a.rebuildIssuesBoard(current)
+		})
+	}()
+}
+
+// moveBoardCursor moves the cursor by columns or rows. Moving between columns
+// skips empty ones. It returns false if there is no card in that direction.
+func (a *App) moveBoardCursor(dCol, dRow int) bool {
+	col, row := a.boardCursorCol, a.boardCursorRow+dRow
+	if dCol != 0 {
+		col += dCol
+		for col >= 0 && col < len(a.boardColumns) && len(a.boardColumns[col].Issues) == 0 {
+			col += dCol
+		}
+		if col >= 0 && col < len(a.boardColumns) && row >= len(a.boardColumns[col].Issues) {
+			row = len(a.boardColumns[col].Issues) - 1
+		}
+	}
+	issue := a.boardCard(col, row)
+	if issue == nil {
+		return false
+	}
+	a.boardCursorCol, a.boardCursorRow = col, row
+	a.issuesBoard.Select(row+1, col)
+	a.onIssueSelected(*issue)
+	return true
+}
+
+// moveBoardCard moves the card under the cursor to the adjacent column and
+// updates the issue's state. The board changes right away; the refresh after
+// the update puts the card back if it fails.
+func (a *App) moveBoardCard(dCol int) {
+	issue := a.boardCard(a.boardCursorCol, a.boardCursorRow)
+	target := a.boardCursorCol + dCol
+	if issue == nil || target < 0 || target >= len(a.boardColumns) {
+		return
+	}
+	column := a.boardColumns[target]
+	if column.StateID == "" {
+		a.updateStatusBarWithError(fmt.Errorf("cannot move %s to %s: state is not one of the team's workflow states", issue.Identifier, column.Name))
+		return
+	}
+	if column.TeamID != "" && issue.TeamID != "" && column.TeamID != issue.TeamID {
+		a.updateStatusBarWithError(fmt.Errorf("cannot move %s to %s: state belongs to another team", issue.Identifier, column.Name))
+		return
+	}
+
+	moved := *issue
+	stateID := column.StateID
+	logger.Info("tui.commands: moving issue on board issue=%s state=%s", moved.Identifier, column.Name)
+
+	a.issuesMu.Lock()
+	for i := range a.issues {
+		if a.issues[i].ID == moved.ID {
+			a.issues[i].StateID = stateID
+			a.issues[i].State = column.Name
+		}
+	}
+	a.issuesMu.Unlock()
+	a.rebuildIssuesBoard(moved.ID)
+
+	go func() {
+		_, err := a.updateIssue(context.Background(), linearapi.UpdateIssueInput{ID: moved.ID, StateID: &stateID})
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.commands: failed to move issue issue=%s", moved.Identifier)
+				a.updateStatusBarWithError(err)
+			} else {
+				logger.Info("tui.commands: moved issue issue=%s state=%s", moved.Identifier, column.Name)
+			}
+			go a.refreshIssues(moved.ID)
+		})
+	}()
+}
+
+// handleBoardKey handles keys for the board. It returns false for keys the
+// board does not use so they reach the issues pane shortcuts.
+func (a *App) handleBoardKey(event *tcell.EventKey) bool {
+	switch event.Key() {
+	case tcell.KeyLeft:
+		if event.Modifiers()&tcell.ModShift != 0 {
+			a.moveBoardCard(-1)
+		} else if !a.moveBoardCursor(-1, 0) {
+			a.focusedPane = FocusNavigation
+			a.updateFocus()
+		}
+		return true
+	case tcell.KeyRight:
+		if event.Modifiers()&tcell.ModShift != 0 {
+			a.moveBoardCard(1)
+		} else if !a.moveBoardCursor(1, 0) {
+			a.focusedPane = FocusDetails
+			a.focusedDetailsView = false // Start with description
+			a.updateFocus()
+		}
+		return true
+	case tcell.KeyUp:
+		a.moveBoardCursor(0, -1)
+		return true
+	case tcell.KeyDown:
+		a.moveBoardCursor(0, 1)
+		return true
+	case tcell.KeyEscape:
+		return a.clearIssueMarks()
+	case tcell.KeyEnter:
+		if issue := a.boardCard(a.boardCursorCol, a.boardCursorRow); issue != nil {
+			a.onIssueSelected(*issue)
+			a.focusedPane = FocusDetails
+			a.updateFocus()
+		}
+		return true
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'h':
+			if !a.moveBoardCursor(-1, 0) {
+				a.focusedPane = FocusNavigation
+				a.updateFocus()
+			}
+			return true
+		case 'l':
+			if !a.moveBoardCursor(1, 0) {
+				a.focusedPane = FocusDetails
+				a.focusedDetailsView = false // Start with description
+				a.updateFocus()
+			}
+			return true
+		case 'j':
+			a.moveBoardCursor(0, 1)
+			return true
+		case 'k':
+			a.moveBoardCursor(0, -1)
+			return true
+		case 'H':
+			a.moveBoardCard(-1)
+			return true
+		case 'L':
+			a.moveBoardCard(1)
+			return true
+		case ' ':
+			if issue := a.boardCard(a.boardCursorCol, a.boardCursorRow); issue != nil {
+				a.toggleIssueMark(issue.ID)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// toggleIssuesLayout switches between the tables and the board and saves the
+// choice to the config file.
+func (a *App) toggleIssuesLayout() {
+	if a.isBoardLayout() {
+		a.config.IssuesLayout = config.IssuesLayoutTable
+	} else {
+		a.config.IssuesLayout = config.IssuesLayoutBoard
+	}
+	logger.Info("tui.commands: switched issues layout layout=%s", a.config.IssuesLayout)
+
+	targetIssueID := ""
+	if issue := a.GetSelectedIssue(); issue != nil {
+		targetIssueID = issue.ID
+	}
+	a.rebuildIssuesTables(targetIssueID)
+	a.focusedPane = FocusIssues
+	a.updateFocus()
+
+	settingsPath, err := config.ConfigFilePath()
+	if err == nil {
+		err = config.SaveSettings(settingsPath, config.SettingsFromConfig(a.config))
+	}
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.commands: failed to save issues layout")
+		a.updateStatusBarWithError(err)
+	}
+}
@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestBuildBoardColumns(t *testing.T) {
+	states := []linearapi.WorkflowState{
+		{ID: "done", Name: "Done", Position: 3, TeamID: "t1"},
+		{ID: "todo", Name: "Todo", Position: 1, TeamID: "t1"},
+		{ID: "doing", Name: "In Progress", Position: 2, TeamID: "t1"},
+	}
+	issues := []linearapi.Issue{
+		{ID: "1", StateID: "doing", State: "In Progress"},
+		{ID: "2", StateID: "todo", State: "Todo"},
+		{ID: "3", StateID: "other", State: "Triage"},
+		{ID: "4", StateID: "doing", State: "In Progress"},
+	}
+
+	columns := buildBoardColumns(states, issues)
+
+	want := []struct {
+		name   string
+		ids    []string
+		hasID  bool
+		teamID string
+	}{
+		{name: "Todo", ids: []string{"2"}, hasID: true, teamID: "t1"},
+		{name: "In Progress", ids: []string{"1", "4"}, hasID: true, teamID: "t1"},
+		{name: "Done", ids: nil, hasID: true, teamID: "t1"},
+		{name: "Triage", ids: []string{"3"}},
+	}
+	if len(columns) != len(want) {
+		t.Fatalf("buildBoardColumns() returned %d columns, want %d", len(columns), len(want))
+	}
+	for i, w := range want {
+		column := columns[i]
+		if column.Name != w.name || (column.StateID != "") != w.hasID || column.TeamID != w.teamID {
+			t.Errorf("column %d = %q (state %q, team %q), want %q", i, column.Name, column.StateID, column.TeamID, w.name)
+		}
+		if len(column.Issues) != len(w.ids) {
+			t.Errorf("column %q has %d issues, want %d", column.Name, len(column.Issues), len(w.ids))
+			continue
+		}
+		for j, id := range w.ids {
+			if column.Issues[j].ID != id {
+				t.Errorf("column %q issue %d = %q, want %q", column.Name, j, column.Issues[j].ID, id)
+			}
+		}
+	}
+
+	if col, row := findBoardCard(columns, "4"); col != 1 || row != 1 {
+		t.Errorf("findBoardCard(4) = %d, %d; want 1, 1", col, row)
+	}
+	if col, row := findBoardCard(columns, "missing"); col != -1 || row != -1 {
+		t.Errorf("findBoardCard(missing) = %d, %d; want -1, -1", col, row)
+	}
+}
+
+func TestBuildBoardColumnsWithoutStates(t *testing.T) {
+	issues := []linearapi.Issue{
+		{ID: "1", StateID: "a", State: "Todo"},
+		{ID: "2", StateID: "b", State: "Done"},
+		{ID: "3", StateID: "a", State: "Todo"},
+	}
+
+	columns := buildBoardColumns(nil, issues)
+
+	if len(columns) != 2 || columns[0].Name != "Todo" || columns[1].Name != "Done" {
+		t.Fatalf("buildBoardColumns(nil) = %+v, want Todo and Done columns", columns)
+	}
+	if len(columns[0].Issues) != 2 || columns[0].StateID != "" {
+		t.Errorf("Todo column = %+v, want 2 issues and no state ID", columns[0])
+	}
+}
+
+func TestMoveBoardCardRejectsInvalidColumns(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 10, CacheTTL: time.Minute}, nil)
+	issue := linearapi.Issue{ID: "1", Identifier: "ENG-1", TeamID: "team-eng", StateID: "todo", State: "Todo"}
+
+	tests := []struct {
+		target boardColumn
+		want   string
+	}{
+		{target: boardColumn{Name: "Triage"}, want: "not one of the team's workflow states"},
+		{target: boardColumn{StateID: "des-done", TeamID: "team-des", Name: "Done"}, want: "belongs to another team"},
+	}
+	for _, tt := range tests {
+		app.boardColumns = []boardColumn{
+			{StateID: "todo", TeamID: "team-eng", Name: "Todo", Issues: []linearapi.Issue{issue}},
+			tt.target,
+		}
+		app.boardCursorCol, app.boardCursorRow = 0, 0
+
+		app.moveBoardCard(1)
+
+		if got := app.statusBar.GetText(true); !strings.Contains(got, tt.want) {
+			t.Errorf("move to %s: status = %q, want %q", tt.target.Name, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -13,6 +14,8 @@ const (
 	IconExpanded    = "▼"
 	IconCollapsed   = "▶"
 	IconChildPrefix = "└─"
+	IconMarked      = "●"
+	IconChanged     = "◆"
 )
 
 // formatPriority formats a priority value into a display string with icon and label.
@@ -96,37 +99,7 @@ func (a *App) buildIssuesTable(title string, section IssuesSection) *tview.Table
 		Background(a.theme.SelectionBg).
 		Bold(true))
 
-	// Set column headers with better styling
-	headerStyle := tcell.StyleDefault.
-		Foreground(a.theme.HeaderText).
-		Background(a.theme.HeaderBg).
-		Bold(true)
-
-	table.SetCell(0, 0, tview.NewTableCell(" ID").
-		SetStyle(headerStyle).
-		SetAlign(tview.AlignLeft).
-		SetSelectable(false).
-		SetExpansion(1))
-	table.SetCell(0, 1, tview.NewTableCell("State").
-		SetStyle(headerStyle).
-		SetAlign(tview.AlignLeft).
-		SetSelectable(false).
-		SetExpansion(1))
-	table.SetCell(0, 2, tview.NewTableCell("Priority").
-		SetStyle(headerStyle).
-		SetAlign(tview.AlignLeft).
-		SetSelectable(false).
-		SetExpansion(1))
-	table.SetCell(0, 3, tview.NewTableCell("Assignee").
-		SetStyle(headerStyle).
-		SetAlign(tview.AlignLeft).
-		SetSelectable(false).
-		SetExpansion(2))
-	table.SetCell(0, 4, tview.NewTableCell("Title").
-		SetStyle(headerStyle).
-		SetAlign(tview.AlignLeft).
-		SetSelectable(false).
-		SetExpansion(6))
+	setIssuesTableHeader(table, a.theme)
 
 	// Set fixed column widths
 	table.SetFixed(1, 0)
@@ -158,7 +131,7 @@ func (a *App) buildIssuesTable(title string, section IssuesSection) *tview.Table
 
 // setupIssuesTableNavigation sets up keyboard navigation for an issues table with cross-section support.
 func (a *App) setupIssuesTableNavigation(table *tview.Table, section IssuesSection) {
-	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+	handleKey := func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyRune:
 			switch event.Rune() {
@@ -270,15 +243,28 @@ func (a *App) setupIssuesTableNavigation(table *tview.Table, section IssuesSecti
 				}
 				return nil
 			case ' ':
-				// Space toggles expand/collapse
+				// Space marks the issue for bulk operations
 				row, _ := table.GetSelection()
 				if issue := a.getIssueFromRowForSection(row, section); issue != nil {
-					if len(issue.Children) > 0 {
-						a.toggleIssueExpanded(issue.ID)
-						a.activeIssuesSection = section
-					}
+					a.toggleIssueMark(issue.ID)
+					a.activeIssuesSection = section
 				}
 				return nil
+			case 'v':
+				// Start or end visual range selection
+				row, _ := table.GetSelection()
+				if issue := a.getIssueFromRowForSection(row, section); issue != nil {
+					a.toggleVisualMode(issue.ID, section)
+					a.activeIssuesSection = section
+				}
+				return nil
+			}
+		case tcell.KeyCtrlA:
+			a.markAllInSection(section)
+			return nil
+		case tcell.KeyEscape:
+			if a.clearIssueMarks() {
+				return nil
 			}
 		case tcell.KeyEnter:
 			row, _ := table.GetSelection()
@@ -338,6 +324,19 @@ func (a *App) setupIssuesTableNavigation(table *tview.Table, section IssuesSecti
 			return nil
 		}
 		return event
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		result := handleKey(event)
+		// Grow the visual range to follow the cursor
+		if a.visualAnchorID != "" {
+			current := a.activeIssuesSection
+			row, _ := a.tableForSection(current).GetSelection()
+			if issue := a.getIssueFromRowForSection(row, current); issue != nil {
+				a.extendVisualSelection(issue.ID, current)
+			}
+		}
+		return result
 	})
 }
 
@@ -368,41 +367,52 @@ func (a *App) getRowForIssueInSection(issueID string, section IssuesSection) int
 	return getRowForIssueModel(issueID, rows)
 }
 
-// renderIssuesTableModel renders a table with the given rows and issue lookup map.
-func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[string]*linearapi.Issue, selectedIssueID string, theme Theme) {
-	table.Clear()
-
-	// Set column headers with better styling
+// setIssuesTableHeader writes the column header row: the change/mark
+// marker column followed by ID, State, Priority, Assignee and Title.
+func setIssuesTableHeader(table *tview.Table, theme Theme) {
 	headerStyle := tcell.StyleDefault.
 		Foreground(theme.HeaderText).
 		Background(theme.HeaderBg).
 		Bold(true)
 
-	table.SetCell(0, 0, tview.NewTableCell(" ID").
+	table.SetCell(0, 0, tview.NewTableCell(" ").
+		SetStyle(headerStyle).
+		SetSelectable(false))
+	table.SetCell(0, 1, tview.NewTableCell(" ID").
 		SetStyle(headerStyle).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
 		SetExpansion(1))
-	table.SetCell(0, 1, tview.NewTableCell("State").
+	table.SetCell(0, 2, tview.NewTableCell("State").
 		SetStyle(headerStyle).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
 		SetExpansion(1))
-	table.SetCell(0, 2, tview.NewTableCell("Priority").
+	table.SetCell(0, 3, tview.NewTableCell("Priority").
 		SetStyle(headerStyle).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
 		SetExpansion(1))
-	table.SetCell(0, 3, tview.NewTableCell("Assignee").
+	table.SetCell(0, 4, tview.NewTableCell("Assignee").
 		SetStyle(headerStyle).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
 		SetExpansion(2))
-	table.SetCell(0, 4, tview.NewTableCell("Title").
+	table.SetCell(0, 5, tview.NewTableCell("Title").
 		SetStyle(headerStyle).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
 		SetExpansion(6))
+}
+
+// renderIssuesTableModel renders a table with the given rows and issue lookup map.
+// Issues in marked are flagged with IconMarked for bulk operations, and
+// issues in changed show IconChanged until their highlight fades.
+func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[string]*linearapi.Issue, selectedIssueID string, marked map[string]bool, changed map[string]time.Time, theme Theme) {
+	table.Clear()
+	now := time.Now()
+
+	setIssuesTableHeader(table, theme)
 
 	// Add issue rows using the hierarchical structure
 	for i, issueRow := range rows {
@@ -429,8 +439,29 @@ func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[s
 			}
 		}
 
-		table.SetCell(row, 0, tview.NewTableCell(identifierPrefix+identifier).
-			SetTextColor(theme.SecondaryText).
+		identifierColor := theme.SecondaryText
+		if marked[issue.ID] {
+			// Replace the leading space with the mark so columns stay aligned
+			identifierPrefix = IconMarked + identifierPrefix[1:]
+			identifierColor = theme.Accent
+		}
+
+		// Recently added or changed issues keep a marker until it fades
+		changeText := " "
+		changeColor := theme.SecondaryText
+		switch changeHighlightLevel(changed[issue.ID], now) {
+		case highlightFresh:
+			changeText = IconChanged
+			changeColor = theme.Accent
+		case highlightFading:
+			changeText = IconChanged
+		}
+		table.SetCell(row, 0, tview.NewTableCell(changeText).
+			SetTextColor(changeColor).
+			SetAlign(tview.AlignLeft))
+
+		table.SetCell(row, 1, tview.NewTableCell(identifierPrefix+identifier).
+			SetTextColor(identifierColor).
 			SetAlign(tview.AlignLeft))
 
 		// State with color based on state
@@ -459,13 +490,13 @@ func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[s
 			state = state[:12]
 		}
 
-		table.SetCell(row, 1, tview.NewTableCell(stateIcon+" "+state).
+		table.SetCell(row, 2, tview.NewTableCell(stateIcon+" "+state).
 			SetTextColor(stateColor).
 			SetAlign(tview.AlignLeft))
 
 		// Priority
 		priorityText, priorityColor := formatPriority(issue.Priority, theme)
-		table.SetCell(row, 2, tview.NewTableCell(priorityText).
+		table.SetCell(row, 3, tview.NewTableCell(priorityText).
 			SetTextColor(priorityColor).
 			SetAlign(tview.AlignLeft))
 
@@ -480,13 +511,13 @@ func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[s
 			assignee = assignee[:15]
 		}
 
-		table.SetCell(row, 3, tview.NewTableCell(assignee).
+		table.SetCell(row, 4, tview.NewTableCell(assignee).
 			SetTextColor(assigneeColor).
 			SetAlign(tview.AlignLeft))
 
 		// Title
 		title := issue.Title
-		table.SetCell(row, 4, tview.NewTableCell(title).
+		table.SetCell(row, 5, tview.NewTableCell(title).
 			SetTextColor(theme.Foreground).
 			SetAlign(tview.AlignLeft))
 	}
@@ -509,11 +540,12 @@ func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[s
 		table.SetCell(1, 0, tview.NewTableCell("").SetSelectable(false))
 		table.SetCell(1, 1, tview.NewTableCell("").SetSelectable(false))
 		table.SetCell(1, 2, tview.NewTableCell("").SetSelectable(false))
-		table.SetCell(1, 3, tview.NewTableCell("No issues").
+		table.SetCell(1, 3, tview.NewTableCell("").SetSelectable(false))
+		table.SetCell(1, 4, tview.NewTableCell("No issues").
 			SetTextColor(theme.SecondaryText).
 			SetAlign(tview.AlignCenter).
 			SetSelectable(false))
-		table.SetCell(1, 4, tview.NewTableCell("").SetSelectable(false))
+		table.SetCell(1, 5, tview.NewTableCell("").SetSelectable(false))
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 )
 
@@ -108,3 +109,25 @@ func TestRenderIssueRow_Truncation(t *testing.T) {
 		t.Errorf("Assignee length = %d, want <= 10", len(row[3]))
 	}
 }
+
+func TestBuildIssuesTable_HeaderMatchesRenderedLayout(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 10, CacheTTL: time.Minute}, nil)
+	table := app.buildIssuesTable(" My Issues ", IssuesSectionMy)
+
+	want := []string{" ", " ID", "State", "Priority", "Assignee", "Title"}
+	if got := table.GetColumnCount(); got != len(want) {
+		t.Fatalf("initial header has %d columns, want %d", got, len(want))
+	}
+	for col, text := range want {
+		if got := table.GetCell(0, col).Text; got != text {
+			t.Errorf("initial header col %d = %q, want %q", col, got, text)
+		}
+	}
+
+	renderIssuesTableModel(table, nil, nil, "", nil, nil, app.theme)
+	for col, text := range want {
+		if got := table.GetCell(0, col).Text; got != text {
+			t.Errorf("rendered header col %d = %q, want %q", col, got, text)
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/webhook"
+)
+
+const (
+	// changeHighlightDuration is how long added or changed rows stay marked.
+	changeHighlightDuration = 30 * time.Second
+
+	// liveStatusInterval is how often fading markers and the "updated ago"
+	// status text are redrawn.
+	liveStatusInterval = 5 * time.Second
+)
+
+// highlightLevel is how strongly a changed row is marked.
+type highlightLevel int
+
+const (
+	highlightNone highlightLevel = iota
+	highlightFresh
+	highlightFading
+)
+
+// startLiveUpdates keeps the issue list current while the app runs. When a
+// webhook address is configured, a receiver patches issues as Linear delivers
+// events; otherwise, or if the receiver cannot start, issues are polled every
+// PollInterval. The returned function stops live updates.
+func (a *App) startLiveUpdates() func() {
+	if a.config.WebhookAddr != "" {
+		server, err := webhook.Listen(a.config.WebhookAddr, a.config.WebhookSecret, func(event webhook.Event) {
+			a.QueueUpdateDraw(func() {
+				a.applyWebhookEvent(event)
+			})
+		})
+		if err == nil {
+			return func() {
+				if err := server.Close(); err != nil {
+					logger.Warning("tui.app: failed to stop webhook receiver error=%v", err)
+				}
+			}
+		}
+		logger.ErrorWithErr(err, "tui.app: webhook receiver unavailable, falling back to polling")
+	}
+
+	interval := a.config.PollInterval
+	if interval <= 0 {
+		logger.Debug("tui.app: live updates disabled")
+		return func() {}
+	}
+
+	logger.Debug("tui.app: polling for issue updates interval=%s", interval)
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				a.QueueUpdateDraw(a.pollIssues)
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// pollIssues refreshes the issue list in the background without moving focus.
+// A poll during a fetch is dropped, since that fetch returns fresh data.
+func (a *App) pollIssues() {
+	if a.isLoading {
+		return
+	}
+	// Run in a goroutine: refreshing queues draws, which deadlocks inside one
+	go a.refreshIssuesWithFocusChange(false)
+}
+
+// applyWebhookEvent patches loaded issues and the details pane with a webhook
+// event. It must run on the UI goroutine.
+func (a *App) applyWebhookEvent(event webhook.Event) {
+	switch {
+	case event.Issue != nil:
+		a.applyIssueEvent(event.Action, *event.Issue)
+	case event.Comment != nil:
+		a.applyCommentEvent(event.Action, *event.Comment)
+	case event.Label != nil:
+		a.applyLabelEvent(event.Action, *event.Label)
+	}
+}
+
+// applyIssueEvent updates, adds, or drops an issue from a webhook event.
+func (a *App) applyIssueEvent(action string, update linearapi.Issue) {
+	matches := a.selectedNavigation == nil || issueMatchesNavigation(update, *a.selectedNavigation)
+	if action == webhook.ActionCreate {
+		// A new issue's place in the list depends on server-side sorting and search
+		if matches {
+			go a.refreshIssuesWithFocusChange(false)
+		}
+		return
+	}
+
+	keep := action != webhook.ActionRemove && !update.Archived && matches
+
+	a.issuesMu.Lock()
+	issues, changed := patchIssue(a.issues, update, keep)
+	if changed {
+		issues = a.withPendingMutations(issues)
+		if a.sortField == SortByPriority {
+			sortIssuesByPriority(issues)
+		}
+		a.issues = issues
+	}
+	selectedID := ""
+	if a.selectedIssue != nil {
+		selectedID = a.selectedIssue.ID
+		if selectedID == update.ID && keep {
+			merged := a.withPendingMutations([]linearapi.Issue{mergeWebhookIssue(*a.selectedIssue, update)})[0]
+			a.selectedIssue = &merged
+			changed = true
+		}
+	}
+	a.issuesMu.Unlock()
+
+	if changed {
+		logger.Debug("tui.app: applied webhook issue event action=%s issue=%s", action, update.Identifier)
+		now := time.Now()
+		if keep {
+			a.changedIssueIDs[update.ID] = now
+		}
+		a.lastRefreshAt = now
+		a.redrawAfterWebhook(selectedID)
+	}
+}
+
+// applyCommentEvent patches the comments of the selected issue.
+func (a *App) applyCommentEvent(action string, comment linearapi.Comment) {
+	if a.currentUser != nil && comment.Author.ID == a.currentUser.ID {
+		comment.Author.IsMe = true
+	}
+
+	a.issuesMu.Lock()
+	if a.selectedIssue == nil || a.selectedIssue.ID != comment.IssueID {
+		a.issuesMu.Unlock()
+		return
+	}
+	issue := *a.selectedIssue
+	issue.Comments = patchComments(issue.Comments, action, comment)
+	a.selectedIssue = &issue
+	a.issuesMu.Unlock()
+
+	logger.Debug("tui.app: applied webhook comment event action=%s issue=%s", action, issue.Identifier)
+	a.updateDetailsView()
+}
+
+// applyLabelEvent renames, recolors, or removes a label on loaded issues.
+func (a *App) applyLabelEvent(action string, label linearapi.IssueLabel) {
+	a.issuesMu.Lock()
+	issues := append([]linearapi.Issue(nil), a.issues...)
+	changed := patchLabels(issues, action, label)
+	if changed {
+		a.issues = issues
+	}
+	selectedID := ""
+	if a.selectedIssue != nil {
+		selectedID = a.selectedIssue.ID
+		selected := []linearapi.Issue{*a.selectedIssue}
+		if patchLabels(selected, action, label) {
+			a.selectedIssue = &selected[0]
+			changed = true
+		}
+	}
+	a.issuesMu.Unlock()
+
+	if changed {
+		logger.Debug("tui.app: applied webhook label event action=%s label=%s", action, label.Name)
+		a.redrawAfterWebhook(selectedID)
+	}
+}
+
+// redrawAfterWebhook re-renders the issue list after a live update, keeping
+// the selection on selectedID when it is still listed.
+func (a *App) redrawAfterWebhook(selectedID string) {
+	selected := a.rebuildIssuesTables(selectedID)
+	switch {
+	case selected == nil:
+		a.issuesMu.Lock()
+		a.selectedIssue = nil
+		a.issuesMu.Unlock()
+		a.updateDetailsView()
+	case selected.ID != selectedID:
+		// The selected issue was removed; follow the new selection
+		a.onIssueSelected(*selected)
+	default:
+		a.updateDetailsView()
+	}
+	a.updateStatusBar()
+}
+
+// startLiveStatusTicker periodically fades change markers and updates the
+// "updated ago" text in the status bar.
+func (a *App) startLiveStatusTicker() {
+	go func() {
+		ticker := time.NewTicker(liveStatusInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			a.QueueUpdateDraw(a.tickLiveStatus)
+		}
+	}()
+}
+
+// tickLiveStatus drops expired change markers, redraws the tables while any
+// marker is visible, and refreshes the status bar unless it is showing a
+// message such as an error.
+func (a *App) tickLiveStatus() {
+	if len(a.changedIssueIDs) > 0 && !a.isBoardLayout() {
+		now := time.Now()
+		for id, changedAt := range a.changedIssueIDs {
+			if changeHighlightLevel(changedAt, now) == highlightNone {
+				delete(a.changedIssueIDs, id)
+			}
+		}
+		renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, a.selectedIssueID(IssuesSectionMy), a.markedIssueIDs, a.changedIssueIDs, a.theme)
+		renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, a.selectedIssueID(IssuesSectionOther), a.markedIssueIDs, a.changedIssueIDs, a.theme)
+	}
+	if a.statusBar.GetText(false) == a.statusBarText {
+		a.updateStatusBar()
+	}
+}
+
+// markIssueChanges records issues that were added or updated since the
+// previous refresh of the same list. firstPage starts a new refresh: when the
+// list scope (navigation, search, sort) changed, the markers are cleared and
+// nothing is highlighted until the next refresh.
+func (a *App) markIssueChanges(issues []linearapi.Issue, firstPage bool) {
+	now := time.Now()
+	if a.changedIssueIDs == nil {
+		a.changedIssueIDs = make(map[string]time.Time)
+	}
+	if firstPage {
+		a.lastRefreshAt = now
+		scope := a.issueListScope()
+		if scope != a.changeScope {
+			a.changeScope = scope
+			a.changeBaseline = nil
+			clear(a.changedIssueIDs)
+			return
+		}
+		a.issuesMu.RLock()
+		a.changeBaseline = issueVersions(a.issues)
+		a.issuesMu.RUnlock()
+	}
+	if a.changeBaseline == nil {
+		return
+	}
+	for _, id := range changedIssues(a.changeBaseline, issues) {
+		a.changedIssueIDs[id] = now
+	}
+}
+
+// issueListScope identifies the issue list being shown, so that changes are
+// only highlighted between refreshes of the same list.
+func (a *App) issueListScope() string {
+	nav := ""
+	if node := a.selectedNavigation; node != nil {
+		nav = strings.Join([]string{node.ID, node.TeamID, node.StateID, node.CycleID, node.ProjectID, node.ViewName}, "/")
+	}
+	return nav + "|" + a.searchQuery + "|" + string(a.sortField)
+}
+
+// issueVersions maps issue IDs to their last update time.
+func issueVersions(issues []linearapi.Issue) map[string]time.Time {
+	versions := make(map[string]time.Time, len(issues))
+	for _, issue := range issues {
+		versions[issue.ID] = issue.UpdatedAt
+	}
+	return versions
+}
+
+// changedIssues returns the IDs of issues that are missing from baseline or
+// were updated after their baseline version.
+func changedIssues(baseline map[string]time.Time, issues []linearapi.Issue) []string {
+	var changed []string
+	for _, issue := range issues {
+		updatedAt, ok := baseline[issue.ID]
+		if !ok || issue.UpdatedAt.After(updatedAt) {
+			changed = append(changed, issue.ID)
+		}
+	}
+	return changed
+}
+
+// changeHighlightLevel returns how strongly to mark a row changed at
+// changedAt: fresh for the first third of changeHighlightDuration, then fading.
+func changeHighlightLevel(changedAt, now time.Time) highlightLevel {
+	if changedAt.IsZero() {
+		return highlightNone
+	}
+	switch age := now.Sub(changedAt); {
+	case age < changeHighlightDuration/3:
+		return highlightFresh
+	case age < changeHighlightDuration:
+		return highlightFading
+	default:
+		return highlightNone
+	}
+}
+
+// formatUpdatedAgo describes how long ago the issue list was refreshed.
+func formatUpdatedAgo(age time.Duration) string {
+	switch {
+	case age < 10*time.Second:
+		return "updated just now"
+	case age < time.Minute:
+		return fmt.Sprintf("updated %ds ago", int(age/(10*time.Second))*10)
+	case age < time.Hour:
+		return fmt.Sprintf("updated %dm ago", int(age/time.Minute))
+	default:
+		return fmt.Sprintf("updated %dh ago", int(age/time.Hour))
+	}
+}
+
+// issueMatchesNavigation reports whether an issue still belongs in the list
+// for a navigation node. Saved views are treated as matching because their
+// filters are only known to the server.
+func issueMatchesNavigation(issue linearapi.Issue, nav NavigationNode) bool {
+	switch {
+	case nav.IsStatus:
+		return issue.TeamID == nav.TeamID && issue.StateID == nav.StateID
+	case nav.IsCycle:
+		return issue.TeamID == nav.TeamID && issue.Cycle != nil && issue.Cycle.ID == nav.CycleID
+	case nav.IsProject:
+		return issue.ProjectID == nav.ID
+	case nav.IsTeam:
+		return issue.TeamID == nav.TeamID
+	default:
+		return true
+	}
+}
+
+// patchIssue replaces the issue matching update.ID with the merged update, or
+// removes it when keep is false. Issues that are not loaded are ignored. It
+// returns the new slice and whether anything changed.
+func patchIssue(issues []linearapi.Issue, update linearapi.Issue, keep bool) ([]linearapi.Issue, bool) {
+	for i, issue := range issues {
+		if issue.ID != update.ID {
+			continue
+		}
+		patched := make([]linearapi.Issue, 0, len(issues))
+		patched = append(patched, issues[:i]...)
+		if keep {
+			patched = append(patched, mergeWebhookIssue(issue, update))
+		}
+		patched = append(patched, issues[i+1:]...)
+		return patched, true
+	}
+	return issues, false
+}
+
+// mergeWebhookIssue applies a webhook issue update to a loaded issue. Webhook
+// payloads carry only IDs for some references and no comments or relations,
+// so those are kept from the loaded issue when they still apply.
+func mergeWebhookIssue(existing, update linearapi.Issue) linearapi.Issue {
+	merged := update
+	merged.Comments = existing.Comments
+	merged.Relations = existing.Relations
+	merged.Children = existing.Children
+	if merged.State == "" && merged.StateID == existing.StateID {
+		merged.State = existing.State
+	}
+	if merged.Assignee == "" && merged.AssigneeID == existing.AssigneeID {
+		merged.Assignee = existing.Assignee
+	}
+	if merged.Cycle != nil && existing.Cycle != nil && merged.Cycle.ID == existing.Cycle.ID {
+		merged.Cycle = existing.Cycle
+	}
+	if merged.Parent != nil && existing.Parent != nil && merged.Parent.ID == existing.Parent.ID {
+		merged.Parent = existing.Parent
+	}
+	if merged.URL == "" {
+		merged.URL = existing.URL
+	}
+	return merged
+}
+
+// patchComments applies a comment webhook event to an issue's comments.
+func patchComments(comments []linearapi.Comment, action string, comment linearapi.Comment) []linearapi.Comment {
+	patched := make([]linearapi.Comment, 0, len(comments)+1)
+	found := false
+	for _, existing := range comments {
+		if existing.ID != comment.ID {
+			patched = append(patched, existing)
+			continue
+		}
+		found = true
+		if action != webhook.ActionRemove {
+			patched = append(patched, comment)
+		}
+	}
+	if !found && action != webhook.ActionRemove {
+		patched = append(patched, comment)
+	}
+	return patched
+}
+
+// patchLabels renames and recolors a label on issues, or removes it when the
+// label was deleted. Changed issues get a new label slice. It reports whether
+// any issue had the label.
+func patchLabels(issues []linearapi.Issue, action string, label linearapi.IssueLabel) bool {
+	changed := false
+	for i := range issues {
+		if !slices.ContainsFunc(issues[i].Labels, func(existing linearapi.IssueLabel) bool {
+			return existing.ID == label.ID
+		}) {
+			continue
+		}
+		labels := make([]linearapi.IssueLabel, 0, len(issues[i].Labels))
+		for _, existing := range issues[i].Labels {
+			if existing.ID != label.ID {
+				labels = append(labels, existing)
+			} else if action != webhook.ActionRemove {
+				labels = append(labels, label)
+			}
+		}
+		issues[i].Labels = labels
+		changed = true
+	}
+	return changed
+}
@@ -0,0 +1,213 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/webhook"
+)
+
+func TestPatchIssue(t *testing.T) {
+	issues := []linearapi.Issue{
+		{ID: "1", Title: "First"},
+		{
+			ID:       "2",
+			Title:    "Second",
+			State:    "Todo",
+			StateID:  "state-todo",
+			Cycle:    &linearapi.CycleRef{ID: "cycle-1", Name: "Cycle 1", Number: 1},
+			Comments: []linearapi.Comment{{ID: "c1"}},
+		},
+		{ID: "3", Title: "Third"},
+	}
+
+	update := linearapi.Issue{ID: "2", Title: "Second (edited)", StateID: "state-todo", Cycle: &linearapi.CycleRef{ID: "cycle-1"}}
+	patched, changed := patchIssue(issues, update, true)
+	if !changed || len(patched) != 3 {
+		t.Fatalf("patchIssue() = %d issues, changed=%v", len(patched), changed)
+	}
+	got := patched[1]
+	if got.Title != "Second (edited)" || got.State != "Todo" {
+		t.Errorf("patched issue = %+v, want new title and kept state name", got)
+	}
+	if got.Cycle == nil || got.Cycle.Name != "Cycle 1" {
+		t.Errorf("patched cycle = %+v, want loaded cycle kept", got.Cycle)
+	}
+	if len(got.Comments) != 1 {
+		t.Errorf("patched comments = %+v, want loaded comments kept", got.Comments)
+	}
+	if issues[1].Title != "Second" {
+		t.Error("patchIssue() modified the input slice")
+	}
+
+	removed, changed := patchIssue(issues, update, false)
+	if !changed || len(removed) != 2 || removed[1].ID != "3" {
+		t.Errorf("patchIssue(keep=false) = %+v, want issue 2 removed", removed)
+	}
+
+	if _, changed := patchIssue(issues, linearapi.Issue{ID: "unknown"}, true); changed {
+		t.Error("patchIssue() changed = true for an issue that is not loaded")
+	}
+}
+
+func TestMergeWebhookIssueChangedReferences(t *testing.T) {
+	existing := linearapi.Issue{
+		ID:         "1",
+		State:      "Todo",
+		StateID:    "state-todo",
+		Assignee:   "Alice",
+		AssigneeID: "user-alice",
+		Parent:     &linearapi.IssueRef{ID: "p1", Identifier: "ENG-1"},
+	}
+	update := linearapi.Issue{ID: "1", StateID: "state-done", AssigneeID: "user-bob", Parent: &linearapi.IssueRef{ID: "p2"}}
+
+	merged := mergeWebhookIssue(existing, update)
+	if merged.State != "" || merged.Assignee != "" {
+		t.Errorf("merged state/assignee = %q/%q, want stale names dropped", merged.State, merged.Assignee)
+	}
+	if merged.Parent == nil || merged.Parent.ID != "p2" || merged.Parent.Identifier != "" {
+		t.Errorf("merged parent = %+v, want new parent reference", merged.Parent)
+	}
+}
+
+func TestIssueMatchesNavigation(t *testing.T) {
+	issue := linearapi.Issue{
+		TeamID:    "team-1",
+		StateID:   "state-1",
+		ProjectID: "project-1",
+		Cycle:     &linearapi.CycleRef{ID: "cycle-1"},
+	}
+
+	tests := []struct {
+		name string
+		nav  NavigationNode
+		want bool
+	}{
+		{name: "all issues", nav: NavigationNode{ID: "all"}, want: true},
+		{name: "team", nav: NavigationNode{IsTeam: true, TeamID: "team-1"}, want: true},
+		{name: "other team", nav: NavigationNode{IsTeam: true, TeamID: "team-2"}, want: false},
+		{name: "status", nav: NavigationNode{IsStatus: true, TeamID: "team-1", StateID: "state-1"}, want: true},
+		{name: "other status", nav: NavigationNode{IsStatus: true, TeamID: "team-1", StateID: "state-2"}, want: false},
+		{name: "cycle", nav: NavigationNode{IsCycle: true, TeamID: "team-1", CycleID: "cycle-1"}, want: true},
+		{name: "project", nav: NavigationNode{IsProject: true, ID: "project-2"}, want: false},
+		{name: "saved view", nav: NavigationNode{IsView: true, StateID: "state-2"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := issueMatchesNavigation(issue, tt.nav); got != tt.want {
+				t.Errorf("issueMatchesNavigation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatchComments(t *testing.T) {
+	comments := []linearapi.Comment{{ID: "c1", Body: "first"}, {ID: "c2", Body: "second"}}
+
+	got := patchComments(comments, webhook.ActionCreate, linearapi.Comment{ID: "c3", Body: "third"})
+	if len(got) != 3 || got[2].ID != "c3" {
+		t.Errorf("create = %+v", got)
+	}
+
+	got = patchComments(comments, webhook.ActionUpdate, linearapi.Comment{ID: "c1", Body: "edited"})
+	if len(got) != 2 || got[0].Body != "edited" {
+		t.Errorf("update = %+v", got)
+	}
+
+	got = patchComments(comments, webhook.ActionRemove, linearapi.Comment{ID: "c2"})
+	if len(got) != 1 || got[0].ID != "c1" {
+		t.Errorf("remove = %+v", got)
+	}
+
+	// A create for an already loaded comment is not duplicated
+	got = patchComments(comments, webhook.ActionCreate, linearapi.Comment{ID: "c1", Body: "first"})
+	if len(got) != 2 {
+		t.Errorf("duplicate create = %+v", got)
+	}
+}
+
+func TestPatchLabels(t *testing.T) {
+	labels := []linearapi.IssueLabel{{ID: "bug", Name: "Bug"}, {ID: "ui", Name: "UI"}}
+	issues := []linearapi.Issue{
+		{ID: "1", Labels: labels},
+		{ID: "2", Labels: []linearapi.IssueLabel{{ID: "ui", Name: "UI"}}},
+	}
+
+	if !patchLabels(issues, webhook.ActionUpdate, linearapi.IssueLabel{ID: "bug", Name: "Defect", Color: "#f00"}) {
+		t.Fatal("patchLabels(update) changed = false")
+	}
+	if issues[0].Labels[0].Name != "Defect" || issues[0].Labels[0].Color != "#f00" {
+		t.Errorf("updated labels = %+v", issues[0].Labels)
+	}
+	if labels[0].Name != "Bug" {
+		t.Error("patchLabels() modified a shared label slice")
+	}
+
+	if !patchLabels(issues, webhook.ActionRemove, linearapi.IssueLabel{ID: "ui"}) {
+		t.Fatal("patchLabels(remove) changed = false")
+	}
+	if len(issues[0].Labels) != 1 || len(issues[1].Labels) != 0 {
+		t.Errorf("labels after remove = %+v, %+v", issues[0].Labels, issues[1].Labels)
+	}
+
+	if patchLabels(issues, webhook.ActionUpdate, linearapi.IssueLabel{ID: "unknown"}) {
+		t.Error("patchLabels() changed = true for an unused label")
+	}
+}
+
+func TestChangedIssues(t *testing.T) {
+	earlier := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Minute)
+	baseline := issueVersions([]linearapi.Issue{
+		{ID: "same", UpdatedAt: earlier},
+		{ID: "edited", UpdatedAt: earlier},
+		{ID: "removed", UpdatedAt: earlier},
+	})
+
+	got := changedIssues(baseline, []linearapi.Issue{
+		{ID: "same", UpdatedAt: earlier},
+		{ID: "edited", UpdatedAt: later},
+		{ID: "added", UpdatedAt: earlier},
+	})
+	if want := []string{"edited", "added"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changedIssues() = %v, want %v", got, want)
+	}
+}
+
+func TestChangeHighlightLevel(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		changedAt time.Time
+		want      highlightLevel
+	}{
+		{name: "never changed", changedAt: time.Time{}, want: highlightNone},
+		{name: "just changed", changedAt: now, want: highlightFresh},
+		{name: "fading", changedAt: now.Add(-changeHighlightDuration / 2), want: highlightFading},
+		{name: "expired", changedAt: now.Add(-changeHighlightDuration), want: highlightNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changeHighlightLevel(tt.changedAt, now); got != tt.want {
+				t.Errorf("changeHighlightLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatUpdatedAgo(t *testing.T) {
+	tests := map[time.Duration]string{
+		3 * time.Second:  "updated just now",
+		34 * time.Second: "updated 30s ago",
+		90 * time.Second: "updated 1m ago",
+		2 * time.Hour:    "updated 2h ago",
+	}
+	for age, want := range tests {
+		if got := formatUpdatedAgo(age); got != want {
+			t.Errorf("formatUpdatedAgo(%v) = %q, want %q", age, got, want)
+		}
+	}
+}
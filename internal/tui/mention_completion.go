@@ -0,0 +1,373 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+const (
+	// maxMentionSuggestions limits the mention completion popup.
+	maxMentionSuggestions = 6
+	// mentionSearchDelay debounces issue searches while typing.
+	mentionSearchDelay = 150 * time.Millisecond
+	// mentionPopupWidth is the width of the completion popup.
+	mentionPopupWidth = 50
+)
+
+// issueIdentifierPrefix matches a partially typed issue identifier (e.g. "ENG-" or "ENG-12").
+var issueIdentifierPrefix = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,6}-[0-9]*$`)
+
+// mentionKind identifies what a completion token refers to.
+type mentionKind int
+
+const (
+	mentionNone  mentionKind = iota
+	mentionUser              // @name
+	mentionIssue             // #text or ENG-12
+)
+
+// mentionToken is the word being typed before the cursor.
+type mentionToken struct {
+	Kind  mentionKind
+	Start int    // Byte offset of the token in the text
+	Query string // Text to complete, without the trigger character
+}
+
+// mentionSuggestion is an entry in the completion popup.
+type mentionSuggestion struct {
+	Label    string // Text shown in the popup
+	Insert   string // Text inserted into the text area
+	Markdown string // Linear mention markdown the inserted text expands to on submit
+}
+
+// mentionTokenAt returns the mention token that ends at cursor, if any.
+func mentionTokenAt(text string, cursor int) mentionToken {
+	if cursor < 0 || cursor > len(text) {
+		return mentionToken{}
+	}
+	start := strings.LastIndexFunc(text[:cursor], unicode.IsSpace) + 1
+	word := text[start:cursor]
+
+	switch {
+	case strings.HasPrefix(word, "@"):
+		query := word[1:]
+		if strings.IndexFunc(query, func(r rune) bool { return !isMentionNameRune(r) }) >= 0 {
+			return mentionToken{}
+		}
+		return mentionToken{Kind: mentionUser, Start: start, Query: query}
+	case strings.HasPrefix(word, "#") && len(word) > 1:
+		return mentionToken{Kind: mentionIssue, Start: start, Query: word[1:]}
+	case issueIdentifierPrefix.MatchString(word):
+		return mentionToken{Kind: mentionIssue, Start: start, Query: word}
+	}
+	return mentionToken{}
+}
+
+// isMentionNameRune reports whether r can be part of a mention name.
+func isMentionNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.'
+}
+
+// isMentionBoundary reports whether position i of text is outside a mention
+// name (text boundaries count as outside).
+func isMentionBoundary(text string, i int) bool {
+	if i < 0 || i >= len(text) {
+		return true
+	}
+	c := text[i]
+	return !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-')
+}
+
+// userMentionSuggestions returns users whose display name or name matches
+// query, with prefix matches first.
+func userMentionSuggestions(users []linearapi.User, query string) []mentionSuggestion {
+	query = strings.ToLower(query)
+	var prefix, contains []mentionSuggestion
+	for _, user := range users {
+		handle := user.DisplayName
+		if handle == "" {
+			handle = strings.ReplaceAll(user.Name, " ", "")
+		}
+		displayName := strings.ToLower(user.DisplayName)
+		name := strings.ToLower(user.Name)
+		suggestion := mentionSuggestion{
+			Label:    fmt.Sprintf("@%s  %s", handle, user.Name),
+			Insert:   "@" + handle,
+			Markdown: user.URL,
+		}
+		switch {
+		case strings.HasPrefix(displayName, query) || strings.HasPrefix(name, query):
+			prefix = append(prefix, suggestion)
+		case strings.Contains(displayName, query) || strings.Contains(name, query):
+			contains = append(contains, suggestion)
+		}
+	}
+	suggestions := append(prefix, contains...)
+	if len(suggestions) > maxMentionSuggestions {
+		suggestions = suggestions[:maxMentionSuggestions]
+	}
+	return suggestions
+}
+
+// issueMentionSuggestions returns completion entries for issues.
+func issueMentionSuggestions(issues []linearapi.Issue) []mentionSuggestion {
+	suggestions := make([]mentionSuggestion, 0, len(issues))
+	for _, issue := range issues {
+		suggestions = append(suggestions, mentionSuggestion{
+			Label:    fmt.Sprintf("%s  %s", issue.Identifier, issue.Title),
+			Insert:   issue.Identifier,
+			Markdown: issue.URL,
+		})
+		if len(suggestions) == maxMentionSuggestions {
+			break
+		}
+	}
+	return suggestions
+}
+
+// expandMentions replaces completed mentions (e.g. "@alice" or "ENG-12") with
+// their Linear mention markdown. Only whole words are replaced.
+func expandMentions(text string, mentions map[string]string) string {
+	if len(mentions) == 0 {
+		return text
+	}
+	tokens := make([]string, 0, len(mentions))
+	for token := range mentions {
+		tokens = append(tokens, token)
+	}
+	// Longest first, so "ENG-12" wins over "ENG-1"
+	sort.Slice(tokens, func(i, j int) bool {
+		if len(tokens[i]) != len(tokens[j]) {
+			return len(tokens[i]) > len(tokens[j])
+		}
+		return tokens[i] < tokens[j]
+	})
+
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		replaced := false
+		if isMentionBoundary(text, i-1) {
+			for _, token := range tokens {
+				end := i + len(token)
+				if strings.HasPrefix(text[i:], token) && isMentionBoundary(text, end) {
+					b.WriteString(mentions[token])
+					i = end
+					replaced = true
+					break
+				}
+			}
+		}
+		if !replaced {
+			b.WriteByte(text[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// MentionCompleter shows an inline completion popup for @user mentions and
+// issue references while typing in a text area.
+type MentionCompleter struct {
+	app         *App
+	textArea    *tview.TextArea
+	list        *tview.List
+	teamID      string
+	token       mentionToken
+	suggestions []mentionSuggestion
+	mentions    map[string]string // Inserted text -> mention markdown
+	generation  atomic.Int64      // Discards results of outdated lookups
+}
+
+// NewMentionCompleter attaches mention completion to a text area.
+func NewMentionCompleter(app *App, textArea *tview.TextArea) *MentionCompleter {
+	mc := &MentionCompleter{
+		app:      app,
+		textArea: textArea,
+		mentions: make(map[string]string),
+	}
+
+	mc.list = tview.NewList().
+		ShowSecondaryText(false).
+		SetMainTextColor(app.theme.Foreground).
+		SetSelectedBackgroundColor(app.theme.Accent).
+		SetSelectedTextColor(app.theme.SelectionText).
+		SetHighlightFullLine(true)
+	mc.list.SetBackgroundColor(app.theme.HeaderBg)
+	mc.list.SetBorder(true).
+		SetBorderColor(app.theme.Accent).
+		SetTitle(" Tab: insert • Esc: dismiss ").
+		SetTitleColor(app.theme.SecondaryText)
+
+	textArea.SetChangedFunc(mc.update)
+	return mc
+}
+
+// Reset clears inserted mentions and sets the team whose users are suggested.
+func (mc *MentionCompleter) Reset(teamID string) {
+	mc.teamID = teamID
+	mc.mentions = make(map[string]string)
+	mc.generation.Add(1) // Ignore lookups triggered by prefilled text
+	mc.hide()
+}
+
+// Visible reports whether the completion popup is shown.
+func (mc *MentionCompleter) Visible() bool {
+	return mc.app.pages.HasPage("mentions")
+}
+
+// Expand replaces mentions inserted through completion with Linear mention markdown.
+func (mc *MentionCompleter) Expand(text string) string {
+	return expandMentions(text, mc.mentions)
+}
+
+// HandleKey handles popup navigation while it is visible. It returns nil when
+// the event was consumed.
+func (mc *MentionCompleter) HandleKey(event *tcell.EventKey) *tcell.EventKey {
+	if !mc.Visible() || !mc.textArea.HasFocus() {
+		return event
+	}
+	current := mc.list.GetCurrentItem()
+	switch event.Key() {
+	case tcell.KeyEscape:
+		mc.hide()
+		return nil
+	case tcell.KeyUp, tcell.KeyCtrlP:
+		if current > 0 {
+			mc.list.SetCurrentItem(current - 1)
+		}
+		return nil
+	case tcell.KeyDown, tcell.KeyCtrlN:
+		if current < mc.list.GetItemCount()-1 {
+			mc.list.SetCurrentItem(current + 1)
+		}
+		return nil
+	case tcell.KeyTab, tcell.KeyEnter:
+		if event.Key() == tcell.KeyEnter && event.Modifiers()&(tcell.ModCtrl|tcell.ModMeta) != 0 {
+			return event // Submit shortcut
+		}
+		mc.accept()
+		return nil
+	}
+	return event
+}
+
+// update looks up suggestions for the token before the cursor.
+func (mc *MentionCompleter) update() {
+	_, _, cursor := mc.textArea.GetSelection()
+	token := mentionTokenAt(mc.textArea.GetText(), cursor)
+	generation := mc.generation.Add(1)
+	if token.Kind == mentionNone || (token.Kind == mentionUser && mc.teamID == "") {
+		mc.hide()
+		return
+	}
+	mc.token = token
+
+	go func() {
+		var suggestions []mentionSuggestion
+		ctx := context.Background()
+		switch token.Kind {
+		case mentionUser:
+			users, err := mc.app.GetCache().GetUsers(ctx, mc.teamID)
+			if err != nil {
+				logger.Warning("tui.mentions: failed to load users team_id=%s error=%v", mc.teamID, err)
+				return
+			}
+			suggestions = userMentionSuggestions(users, token.Query)
+		case mentionIssue:
+			time.Sleep(mentionSearchDelay)
+			if mc.generation.Load() != generation {
+				return
+			}
+			page, err := mc.app.services().fetchIssuesPage(ctx, linearapi.FetchIssuesParams{
+				Search: token.Query,
+				First:  maxMentionSuggestions,
+			}, nil)
+			if err != nil {
+				logger.Warning("tui.mentions: issue search failed query=%s error=%v", token.Query, err)
+				return
+			}
+			suggestions = issueMentionSuggestions(page.Issues)
+		}
+
+		mc.app.QueueUpdateDraw(func() {
+			if mc.generation.Load() != generation {
+				return
+			}
+			mc.show(suggestions)
+		})
+	}()
+}
+
+// show displays suggestions below the cursor.
+func (mc *MentionCompleter) show(suggestions []mentionSuggestion) {
+	mc.suggestions = suggestions
+	if len(suggestions) == 0 {
+		mc.hide()
+		return
+	}
+
+	mc.list.Clear()
+	for _, suggestion := range suggestions {
+		mc.list.AddItem(tview.Escape(suggestion.Label), "", 0, nil)
+	}
+
+	// Place the popup under the cursor, or above it near the bottom of the screen
+	x, y, _, _ := mc.textArea.GetInnerRect()
+	_, _, row, column := mc.textArea.GetCursor()
+	rowOffset, columnOffset := mc.textArea.GetOffset()
+	_, _, screenWidth, screenHeight := mc.app.pages.GetRect()
+	popupX := x + column - columnOffset
+	popupY := y + row - rowOffset + 1
+	height := len(suggestions) + 2
+	if popupX+mentionPopupWidth > screenWidth {
+		popupX = max(0, screenWidth-mentionPopupWidth)
+	}
+	if popupY+height > screenHeight {
+		popupY = max(0, popupY-height-1)
+	}
+	mc.list.SetRect(popupX, popupY, mentionPopupWidth, height)
+
+	if !mc.Visible() {
+		mc.app.pages.AddPage("mentions", mc.list, false, true)
+	}
+	mc.app.pages.SendToFront("mentions")
+	mc.app.app.SetFocus(mc.textArea)
+}
+
+// hide removes the popup and keeps focus in the text area.
+func (mc *MentionCompleter) hide() {
+	mc.suggestions = nil
+	if !mc.Visible() {
+		return
+	}
+	mc.app.pages.RemovePage("mentions")
+	mc.app.app.SetFocus(mc.textArea)
+}
+
+// accept replaces the token with the selected suggestion.
+func (mc *MentionCompleter) accept() {
+	index := mc.list.GetCurrentItem()
+	if index < 0 || index >= len(mc.suggestions) {
+		mc.hide()
+		return
+	}
+	suggestion := mc.suggestions[index]
+	if suggestion.Markdown != "" {
+		mc.mentions[suggestion.Insert] = suggestion.Markdown
+	}
+
+	_, _, cursor := mc.textArea.GetSelection()
+	mc.hide()
+	mc.textArea.Replace(mc.token.Start, cursor, suggestion.Insert+" ")
+}
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestMentionTokenAt(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want mentionToken
+	}{
+		{name: "empty", text: "", want: mentionToken{}},
+		{name: "plain word", text: "hello wor", want: mentionToken{}},
+		{name: "bare at", text: "thanks @", want: mentionToken{Kind: mentionUser, Start: 7, Query: ""}},
+		{name: "user", text: "thanks @ali", want: mentionToken{Kind: mentionUser, Start: 7, Query: "ali"}},
+		{name: "email is not a mention", text: "mail bob@exa", want: mentionToken{}},
+		{name: "user with punctuation", text: "@ali!", want: mentionToken{}},
+		{name: "hash issue", text: "see #login", want: mentionToken{Kind: mentionIssue, Start: 4, Query: "login"}},
+		{name: "bare hash", text: "see #", want: mentionToken{}},
+		{name: "identifier", text: "blocked by ENG-12", want: mentionToken{Kind: mentionIssue, Start: 11, Query: "ENG-12"}},
+		{name: "identifier prefix", text: "ENG-", want: mentionToken{Kind: mentionIssue, Start: 0, Query: "ENG-"}},
+		{name: "lowercase is not an identifier", text: "eng-12", want: mentionToken{}},
+		{name: "after newline", text: "line\n@bo", want: mentionToken{Kind: mentionUser, Start: 5, Query: "bo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mentionTokenAt(tt.text, len(tt.text)); got != tt.want {
+				t.Errorf("mentionTokenAt(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMentionTokenAtCursor(t *testing.T) {
+	got := mentionTokenAt("hi @al and more", 6)
+	want := mentionToken{Kind: mentionUser, Start: 3, Query: "al"}
+	if got != want {
+		t.Errorf("mentionTokenAt() = %+v, want %+v", got, want)
+	}
+	if got := mentionTokenAt("text", 10); got.Kind != mentionNone {
+		t.Errorf("mentionTokenAt() with cursor out of range = %+v, want none", got)
+	}
+}
+
+func TestUserMentionSuggestions(t *testing.T) {
+	users := []linearapi.User{
+		{Name: "Bob Alison", DisplayName: "bob", URL: "https://linear.app/acme/profiles/bob"},
+		{Name: "Alice Smith", DisplayName: "alice", URL: "https://linear.app/acme/profiles/alice"},
+		{Name: "Carol Jones", DisplayName: "", URL: "https://linear.app/acme/profiles/carol"},
+	}
+
+	got := userMentionSuggestions(users, "Ali")
+	if len(got) != 2 {
+		t.Fatalf("userMentionSuggestions() returned %d suggestions, want 2", len(got))
+	}
+	if got[0].Insert != "@alice" || got[1].Insert != "@bob" {
+		t.Errorf("userMentionSuggestions() order = %q, %q; want prefix match first", got[0].Insert, got[1].Insert)
+	}
+	if got[0].Markdown != "https://linear.app/acme/profiles/alice" {
+		t.Errorf("userMentionSuggestions() markdown = %q", got[0].Markdown)
+	}
+
+	got = userMentionSuggestions(users, "carol")
+	if len(got) != 1 || got[0].Insert != "@CarolJones" {
+		t.Errorf("userMentionSuggestions() without display name = %+v", got)
+	}
+
+	if got := userMentionSuggestions(users, ""); len(got) != len(users) {
+		t.Errorf("userMentionSuggestions(\"\") returned %d suggestions, want %d", len(got), len(users))
+	}
+}
+
+func TestExpandMentions(t *testing.T) {
+	mentions := map[string]string{
+		"@alice": "https://linear.app/acme/profiles/alice",
+		"ENG-1":  "https://linear.app/acme/issue/ENG-1/first",
+		"ENG-12": "https://linear.app/acme/issue/ENG-12/login-fails",
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "user and issue",
+			text: "@alice can you look at ENG-12?",
+			want: "https://linear.app/acme/profiles/alice can you look at https://linear.app/acme/issue/ENG-12/login-fails?",
+		},
+		{
+			name: "longest token wins",
+			text: "ENG-1 and ENG-12",
+			want: "https://linear.app/acme/issue/ENG-1/first and https://linear.app/acme/issue/ENG-12/login-fails",
+		},
+		{
+			name: "partial words are kept",
+			text: "@alicex ENG-123 xENG-1",
+			want: "@alicex ENG-123 xENG-1",
+		},
+		{
+			name: "sentence punctuation",
+			text: "Thanks @alice.",
+			want: "Thanks https://linear.app/acme/profiles/alice.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expandMentions(tt.text, mentions); got != tt.want {
+				t.Errorf("expandMentions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := expandMentions("@alice", nil); got != "@alice" {
+		t.Errorf("expandMentions() without mentions = %q", got)
+	}
+}
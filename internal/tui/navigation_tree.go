@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"fmt"
+
 	"github.com/rivo/tview"
 )
 
@@ -8,13 +10,19 @@ import (
 type NavigationNode struct {
 	ID        string
 	Text      string
-	TeamID    string // For team, project, and status nodes
+	TeamID    string // For team, project, status, and cycle nodes
 	Children  []*NavigationNode
 	IsTeam    bool
 	IsProject bool
 	IsStatus  bool
+	IsCycle   bool
+	IsView    bool
+	IsInbox   bool
 	StateID   string
 	StateName string
+	CycleID   string
+	ProjectID string // For view nodes (project nodes use ID)
+	ViewName  string
 }
 
 // buildNavigationTree creates and configures the navigation tree widget.
@@ -39,7 +47,7 @@ func (a *App) buildNavigationTree() *tview.TreeView {
 	tree.SetRoot(root)
 	tree.SetCurrentNode(root)
 
-	// Handle selection for all nodes (teams, projects, and "All Issues")
+	// Handle selection for all nodes (inbox, teams, projects, views, and "All Issues")
 	tree.SetSelectedFunc(func(node *tview.TreeNode) {
 		ref := node.GetReference()
 		if ref != nil {
@@ -56,3 +64,11 @@ func (a *App) buildNavigationTree() *tview.TreeView {
 
 	return tree
 }
+
+// cycleLabel returns the display label for a cycle, preferring its custom name.
+func cycleLabel(number int, name string) string {
+	if name != "" {
+		return fmt.Sprintf("Cycle %d: %s", number, name)
+	}
+	return fmt.Sprintf("Cycle %d", number)
+}
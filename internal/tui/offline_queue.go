@@ -0,0 +1,197 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/offline"
+)
+
+// offlineReplayInterval is how often queued mutations are retried while pending.
+const offlineReplayInterval = 30 * time.Second
+
+// SetOfflineQueue enables queueing of issue updates and comments made while the
+// API is unreachable. It should be called before Run.
+func (a *App) SetOfflineQueue(q *offline.Queue) {
+	a.servicesMu.Lock()
+	a.offlineQueue = q
+	a.servicesMu.Unlock()
+}
+
+// pendingMutationCount returns the number of mutations waiting to be synced.
+func (a *App) pendingMutationCount() int {
+	queue := a.services().offlineQueue
+	if queue == nil {
+		return 0
+	}
+	return queue.Len()
+}
+
+// updateIssue updates an issue through the API. When the API is unreachable and
+// the offline queue is enabled, the update is queued, applied to the local issue
+// list, and reported as successful.
+func (a *App) updateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+	services := a.services()
+	updated, err := services.api.UpdateIssue(ctx, input)
+	if err == nil || services.offlineQueue == nil || !offline.IsNetworkError(err) {
+		return updated, err
+	}
+
+	local, _ := a.localIssue(input.ID)
+	if queueErr := services.offlineQueue.Enqueue(offline.Mutation{
+		Kind:            offline.MutationUpdateIssue,
+		IssueID:         input.ID,
+		IssueIdentifier: local.Identifier,
+		Update:          &input,
+		BaseUpdatedAt:   local.UpdatedAt,
+	}); queueErr != nil {
+		logger.ErrorWithErr(queueErr, "tui.app: failed to queue offline update issue=%s", local.Identifier)
+		return updated, err
+	}
+
+	a.QueueUpdateDraw(func() {
+		a.issuesMu.RLock()
+		issues := make([]linearapi.Issue, len(a.issues))
+		copy(issues, a.issues)
+		a.issuesMu.RUnlock()
+		a.updateIssuesData(issues, input.ID)
+	})
+	return offline.ApplyUpdate(local, input, a.offlineLookup()), nil
+}
+
+// createComment creates a comment through the API, queueing it when the API is
+// unreachable and the offline queue is enabled.
+func (a *App) createComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error) {
+	services := a.services()
+	comment, err := services.api.CreateComment(ctx, input)
+	if err == nil || services.offlineQueue == nil || !offline.IsNetworkError(err) {
+		return comment, err
+	}
+
+	local, _ := a.localIssue(input.IssueID)
+	if queueErr := services.offlineQueue.Enqueue(offline.Mutation{
+		Kind:            offline.MutationCreateComment,
+		IssueID:         input.IssueID,
+		IssueIdentifier: local.Identifier,
+		Comment:         &input,
+		BaseUpdatedAt:   local.UpdatedAt,
+	}); queueErr != nil {
+		logger.ErrorWithErr(queueErr, "tui.app: failed to queue offline comment issue=%s", local.Identifier)
+		return comment, err
+	}
+
+	a.QueueUpdateDraw(func() {
+		a.issuesMu.Lock()
+		if a.selectedIssue != nil && a.selectedIssue.ID == input.IssueID {
+			selected := a.withPendingMutations([]linearapi.Issue{*a.selectedIssue})[0]
+			a.selectedIssue = &selected
+		}
+		a.issuesMu.Unlock()
+		a.updateDetailsView()
+		a.updateStatusBar()
+	})
+	return linearapi.Comment{Body: input.Body, IssueID: input.IssueID}, nil
+}
+
+// localIssue finds an issue in the loaded list or the current selection.
+func (a *App) localIssue(issueID string) (linearapi.Issue, bool) {
+	a.issuesMu.RLock()
+	defer a.issuesMu.RUnlock()
+	if a.selectedIssue != nil && a.selectedIssue.ID == issueID {
+		return *a.selectedIssue, true
+	}
+	for _, issue := range a.issues {
+		if issue.ID == issueID {
+			return issue, true
+		}
+	}
+	return linearapi.Issue{ID: issueID, Identifier: issueID}, false
+}
+
+// withPendingMutations overlays queued mutations onto issues so that offline
+// changes stay visible until they are synced.
+func (a *App) withPendingMutations(issues []linearapi.Issue) []linearapi.Issue {
+	queue := a.services().offlineQueue
+	if queue == nil {
+		return issues
+	}
+	pending := queue.Pending()
+	if len(pending) == 0 {
+		return issues
+	}
+	return offline.ApplyPending(issues, pending, a.offlineLookup())
+}
+
+// offlineLookup builds name lookups for optimistic updates from cached metadata.
+func (a *App) offlineLookup() offline.Lookup {
+	metadata := a.GetCache().Export()
+	lookup := offline.Lookup{CurrentUser: a.currentUser}
+	for _, states := range metadata.States {
+		lookup.States = append(lookup.States, states...)
+	}
+	for _, users := range metadata.Users {
+		lookup.Users = append(lookup.Users, users...)
+	}
+	for _, labels := range metadata.Labels {
+		lookup.Labels = append(lookup.Labels, labels...)
+	}
+	return lookup
+}
+
+// startOfflineReplay periodically replays queued mutations while any are
+// pending. It checks the queue of the active account on each tick, so it keeps
+// working across account switches.
+func (a *App) startOfflineReplay() {
+	go func() {
+		ticker := time.NewTicker(offlineReplayInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if a.pendingMutationCount() > 0 {
+				a.replayOfflineQueue()
+			}
+		}
+	}()
+}
+
+// replayOfflineQueue sends queued mutations to the API in order and reports
+// conflicts and rejected changes in the status bar.
+func (a *App) replayOfflineQueue() {
+	services := a.services()
+	if services.offlineQueue == nil || !a.offlineReplaying.CompareAndSwap(false, true) {
+		return
+	}
+	defer a.offlineReplaying.Store(false)
+
+	logger.Debug("tui.app: replaying offline queue pending=%d", services.offlineQueue.Len())
+	result, err := offline.Replay(context.Background(), services.api, services.offlineQueue)
+
+	a.QueueUpdateDraw(func() {
+		switch {
+		case err != nil:
+			a.updateStatusBarWithError(err)
+		case len(result.Conflicts) > 0 || len(result.Failures) > 0:
+			a.statusBar.SetText(a.themeTags.Warning + replaySummary(result) + "[-]")
+		default:
+			a.updateStatusBar()
+		}
+	})
+	if result.Applied > 0 || len(result.Conflicts) > 0 || len(result.Failures) > 0 {
+		go a.refreshIssues()
+	}
+}
+
+// replaySummary describes discarded mutations after a replay.
+func replaySummary(result offline.ReplayResult) string {
+	var discarded []string
+	for _, conflict := range result.Conflicts {
+		discarded = append(discarded, conflict.Mutation.Describe()+" (changed on server)")
+	}
+	for _, failure := range result.Failures {
+		discarded = append(discarded, fmt.Sprintf("%s (%v)", failure.Mutation.Describe(), failure.Err))
+	}
+	return fmt.Sprintf("Synced %d pending changes, discarded: %s", result.Applied, strings.Join(discarded, ", "))
+}
@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/offline"
+)
+
+// unreachableEndpoint returns the URL of a server that refuses connections.
+func unreachableEndpoint() string {
+	server := httptest.NewServer(nil)
+	server.Close()
+	return server.URL
+}
+
+// newOfflineTestApp returns an app whose API endpoint refuses connections.
+func newOfflineTestApp(t *testing.T) (*App, *offline.Queue) {
+	t.Helper()
+	api := linearapi.NewClient(linearapi.ClientConfig{Token: "test", Endpoint: unreachableEndpoint(), Timeout: time.Second})
+	app := NewApp(api, config.Config{PageSize: 10, CacheTTL: time.Minute}, nil)
+	serializeUpdates(app)
+
+	q, err := offline.OpenQueue(filepath.Join(t.TempDir(), "offline_queue.json"), "owner")
+	if err != nil {
+		t.Fatalf("OpenQueue() error = %v", err)
+	}
+	app.SetOfflineQueue(q)
+	return app, q
+}
+
+// renderIssues shows issues the way a refresh does, on the UI goroutine.
+func renderIssues(app *App, issues []linearapi.Issue) {
+	app.QueueUpdateDraw(func() {
+		app.updateIssuesData(issues)
+	})
+}
+
+func TestUpdateIssue_QueuesWhenOffline(t *testing.T) {
+	app, q := newOfflineTestApp(t)
+	updatedAt := time.Now().Add(-time.Hour)
+	renderIssues(app, []linearapi.Issue{{ID: "issue-1", Identifier: "ENG-1", Title: "Old", UpdatedAt: updatedAt}})
+
+	updated, err := app.updateIssue(context.Background(), linearapi.UpdateIssueInput{ID: "issue-1", Title: stringPtr("New")})
+	if err != nil {
+		t.Fatalf("updateIssue() error = %v, want queued update", err)
+	}
+	if updated.Title != "New" {
+		t.Errorf("updated.Title = %q, want New", updated.Title)
+	}
+
+	pending := q.Pending()
+	if len(pending) != 1 || pending[0].IssueIdentifier != "ENG-1" || !pending[0].BaseUpdatedAt.Equal(updatedAt) {
+		t.Fatalf("pending = %+v, want one update based on local updatedAt", pending)
+	}
+
+	// Re-rendering server data keeps the pending change visible
+	renderIssues(app, []linearapi.Issue{{ID: "issue-1", Identifier: "ENG-1", Title: "Old", UpdatedAt: updatedAt}})
+	app.issuesMu.RLock()
+	title := app.issues[0].Title
+	app.issuesMu.RUnlock()
+	if title != "New" {
+		t.Errorf("rendered title = %q, want pending title New", title)
+	}
+}
+
+func TestCreateComment_QueuesWhenOffline(t *testing.T) {
+	app, q := newOfflineTestApp(t)
+	renderIssues(app, []linearapi.Issue{{ID: "issue-1", Identifier: "ENG-1"}})
+
+	if _, err := app.createComment(context.Background(), linearapi.CreateCommentInput{IssueID: "issue-1", Body: "later"}); err != nil {
+		t.Fatalf("createComment() error = %v, want queued comment", err)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", q.Len())
+	}
+
+	selected := app.GetSelectedIssue()
+	if selected == nil || len(selected.Comments) != 1 || selected.Comments[0].Body != "later" {
+		t.Errorf("selected issue = %+v, want pending comment shown", selected)
+	}
+}
@@ -11,6 +11,7 @@ type PaletteController struct {
 	cursor       int
 	filtered     []Command
 	isSearchMode bool
+	suggestions  []string // Query autocomplete suggestions in search mode
 }
 
 // NewPaletteController creates a new palette controller with the given commands.
@@ -62,8 +63,8 @@ func (p *PaletteController) SetCursor(pos int) {
 	switch {
 	case pos < 0:
 		p.cursor = 0
-	case pos >= len(p.filtered):
-		p.cursor = len(p.filtered) - 1
+	case pos >= p.itemCount():
+		p.cursor = p.itemCount() - 1
 		if p.cursor < 0 {
 			p.cursor = 0
 		}
@@ -81,23 +82,34 @@ func (p *PaletteController) MoveCursorUp() {
 
 // MoveCursorDown moves the cursor down by one.
 func (p *PaletteController) MoveCursorDown() {
-	if p.cursor < len(p.filtered)-1 {
+	if p.cursor < p.itemCount()-1 {
 		p.cursor++
 	}
 }
 
+// itemCount returns the number of rows the cursor can move over: commands,
+// or suggestions in search mode.
+func (p *PaletteController) itemCount() int {
+	if p.isSearchMode {
+		return len(p.suggestions)
+	}
+	return len(p.filtered)
+}
+
 // Reset resets the query and cursor to initial state.
 func (p *PaletteController) Reset() {
 	p.query = ""
 	p.cursor = 0
 	p.filtered = p.commands
 	p.isSearchMode = false
+	p.suggestions = nil
 }
 
 // SetSearchMode sets whether the palette is in search mode.
 // In search mode, the query is used for issue search, not command filtering.
 func (p *PaletteController) SetSearchMode(mode bool) {
 	p.isSearchMode = mode
+	p.suggestions = nil
 	if mode {
 		p.filtered = nil
 	} else {
@@ -105,6 +117,26 @@ func (p *PaletteController) SetSearchMode(mode bool) {
 	}
 }
 
+// SetSuggestions sets the autocomplete suggestions shown in search mode and
+// moves the cursor to the first one.
+func (p *PaletteController) SetSuggestions(suggestions []string) {
+	p.suggestions = suggestions
+	p.cursor = 0
+}
+
+// Suggestions returns the autocomplete suggestions for the search query.
+func (p *PaletteController) Suggestions() []string {
+	return p.suggestions
+}
+
+// SelectedSuggestion returns the suggestion under the cursor, if any.
+func (p *PaletteController) SelectedSuggestion() (string, bool) {
+	if !p.isSearchMode || p.cursor < 0 || p.cursor >= len(p.suggestions) {
+		return "", false
+	}
+	return p.suggestions[p.cursor], true
+}
+
 // IsSearchMode returns whether the palette is in search mode.
 func (p *PaletteController) IsSearchMode() bool {
 	return p.isSearchMode
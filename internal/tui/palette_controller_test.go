@@ -250,3 +250,26 @@ func TestPaletteController_CaseInsensitiveFilter(t *testing.T) {
 		t.Errorf("Searching keyword 'upper' returned %d results, want 1", len(pc.Filtered()))
 	}
 }
+
+func TestPaletteController_Suggestions(t *testing.T) {
+	pc := NewPaletteController([]Command{{ID: "1", Title: "Refresh"}})
+	pc.SetSearchMode(true)
+	pc.SetSuggestions([]string{"label:", "assignee:"})
+
+	pc.MoveCursorDown()
+	pc.MoveCursorDown()
+	if got, ok := pc.SelectedSuggestion(); !ok || got != "assignee:" {
+		t.Errorf("SelectedSuggestion() = %q, %v; want assignee:", got, ok)
+	}
+
+	pc.SetSuggestions(nil)
+	if _, ok := pc.SelectedSuggestion(); ok {
+		t.Error("SelectedSuggestion() should be empty without suggestions")
+	}
+
+	pc.SetSuggestions([]string{"label:"})
+	pc.SetSearchMode(false)
+	if len(pc.Suggestions()) != 0 {
+		t.Error("leaving search mode should clear suggestions")
+	}
+}
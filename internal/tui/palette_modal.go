@@ -81,15 +81,30 @@ func (a *App) buildPaletteModal() *tview.Flex {
 	return modal
 }
 
-// updatePaletteList updates the palette list with filtered commands.
+// updatePaletteList updates the palette list with filtered commands, or with
+// query suggestions in search mode.
 func (a *App) updatePaletteList() {
 	a.paletteList.Clear()
-	filtered := a.paletteCtrl.Filtered()
 	cursor := a.paletteCtrl.Cursor()
+	help := "↑↓ Navigate  •  Enter Execute  •  Esc Close"
+	helpColor := a.theme.SecondaryText
+
+	var items []string
+	if a.paletteCtrl.IsSearchMode() {
+		// Search mode: suggestions for the term being typed, syntax errors in the help line
+		for _, suggestion := range a.paletteCtrl.Suggestions() {
+			items = append(items, fmt.Sprintf("%s%8s[-]  %s", a.themeTags.SecondaryText, "", tview.Escape(suggestion)))
+		}
+		help = "Tab Complete  •  Enter Search  •  Esc Clear"
+		if a.searchQueryErr != nil {
+			help = a.searchQueryErr.Error()
+			helpColor = a.theme.StatusCanceled
+		}
+	}
 
 	// Add all filtered commands to the list with shortcut hints
 	// Format: [shortcut] Command Title - with shortcut right-aligned in a fixed column
-	for _, cmd := range filtered {
+	for _, cmd := range a.paletteCtrl.Filtered() {
 		var shortcutHint string
 		if cmd.ShortcutDisplay != "" {
 			// Use custom display text (e.g., "/" or "Esc")
@@ -105,13 +120,16 @@ func (a *App) updatePaletteList() {
 			// No shortcut - pad with spaces for alignment
 			displayText = fmt.Sprintf("%s%8s[-]  %s", a.themeTags.SecondaryText, "", cmd.Title)
 		}
-		a.paletteList.AddItem(displayText, "", 0, nil)
+		items = append(items, displayText)
+	}
+	for _, item := range items {
+		a.paletteList.AddItem(item, "", 0, nil)
 	}
 
 	// Set selected item to match cursor position
-	if len(filtered) > 0 {
-		if cursor >= len(filtered) {
-			cursor = len(filtered) - 1
+	if len(items) > 0 {
+		if cursor >= len(items) {
+			cursor = len(items) - 1
 		}
 		if cursor < 0 {
 			cursor = 0
@@ -121,7 +139,7 @@ func (a *App) updatePaletteList() {
 
 	// Update modal to show all commands without scrolling
 	// Calculate content height: input (1) + help (1) + spacers + list rows
-	contentHeight := len(filtered) + 2 + (2 * a.density.PaletteSpacerLines)
+	contentHeight := len(items) + 2 + (2 * a.density.PaletteSpacerLines)
 	if contentHeight < 6 {
 		contentHeight = 6 // Minimum height for usability
 	}
@@ -131,8 +149,8 @@ func (a *App) updatePaletteList() {
 	// Rebuild modalContent with correct list height
 	// Create help text with improved formatting
 	helpText := tview.NewTextView()
-	helpText.SetText("↑↓ Navigate  •  Enter Execute  •  Esc Close").
-		SetTextColor(a.theme.SecondaryText).
+	helpText.SetText(help).
+		SetTextColor(helpColor).
 		SetBackgroundColor(a.theme.HeaderBg)
 	helpText.SetTextAlign(tview.AlignCenter)
 
@@ -145,7 +163,7 @@ func (a *App) updatePaletteList() {
 		SetDirection(tview.FlexRow).
 		AddItem(spacerTop, a.density.PaletteSpacerLines, 0, false).
 		AddItem(a.paletteInput, 1, 0, true).
-		AddItem(a.paletteList, len(filtered), 0, false).
+		AddItem(a.paletteList, len(items), 0, false).
 		AddItem(spacerBottom, a.density.PaletteSpacerLines, 0, false).
 		AddItem(helpText, 1, 0, false)
 	a.paletteModalContent.Box = tview.NewBox().SetBackgroundColor(a.theme.HeaderBg)
@@ -119,6 +119,24 @@ func (pm *PickerModal) Hide() {
 		return
 	}
 
+	// Check if inbox modal is visible and restore focus to it
+	if pm.app.pages.HasPage("inbox") {
+		pm.app.pages.SendToFront("inbox")
+		if pm.app.inboxModal != nil {
+			pm.app.app.SetFocus(pm.app.inboxModal.table)
+		}
+		return
+	}
+
+	// Check if agent output modal is visible and restore focus to it
+	if pm.app.pages.HasPage("agent_output") {
+		pm.app.pages.SendToFront("agent_output")
+		if pm.app.agentOutputModal != nil {
+			pm.app.app.SetFocus(pm.app.agentOutputModal.streamView)
+		}
+		return
+	}
+
 	pm.app.updateFocus()
 }
 
@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// showRateLimitCountdown shows a rate-limit error in the status bar and keeps
+// the remaining time updated until the limit resets.
+func (a *App) showRateLimitCountdown(err *linearapi.RateLimitedError) {
+	if err.ResetAt.IsZero() {
+		a.statusBar.SetText(fmt.Sprintf("%sError: %v[-]", a.themeTags.Error, err))
+		return
+	}
+	if err.ResetAt.After(a.rateLimitedUntil) {
+		a.rateLimitedUntil = err.ResetAt
+	}
+	logger.Warning("tui.app: rate limited reset_at=%s", err.ResetAt.Format(time.RFC3339))
+	a.updateStatusBar()
+
+	if !a.rateLimitTicking.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			done := make(chan bool, 1)
+			a.QueueUpdateDraw(func() {
+				a.updateStatusBar()
+				done <- time.Now().After(a.rateLimitedUntil)
+			})
+			if <-done {
+				a.rateLimitTicking.Store(false)
+				return
+			}
+		}
+	}()
+}
+
+// formatCountdown formats a remaining duration as "42s" or "3m05s".
+func formatCountdown(d time.Duration) string {
+	seconds := int(d.Round(time.Second) / time.Second)
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+	return fmt.Sprintf("%dm%02ds", seconds/60, seconds%60)
+}
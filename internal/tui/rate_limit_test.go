@@ -0,0 +1,23 @@
+package tui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatCountdown(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{in: 400 * time.Millisecond, want: "0s"},
+		{in: 42 * time.Second, want: "42s"},
+		{in: 3*time.Minute + 5*time.Second, want: "3m05s"},
+	}
+
+	for _, tt := range tests {
+		if got := formatCountdown(tt.in); got != tt.want {
+			t.Errorf("formatCountdown(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// noDefaultViewID is the picker item ID that clears the startup default view.
+const noDefaultViewID = ""
+
+// SetSavedViews sets the saved views shown in the navigation tree and selects
+// the startup default view, if any. It should be called before Run.
+func (a *App) SetSavedViews(views []config.SavedView) {
+	a.savedViews = views
+	if view, ok := config.DefaultSavedView(views); ok {
+		logger.Debug("tui.app: applying default view name=%s", view.Name)
+		a.applySavedView(view)
+	}
+}
+
+// viewNavigationNode returns the navigation node for a saved view.
+func viewNavigationNode(view config.SavedView) *NavigationNode {
+	return &NavigationNode{
+		ID:        "view:" + view.Name,
+		Text:      view.Name,
+		TeamID:    view.TeamID,
+		IsView:    true,
+		ViewName:  view.Name,
+		ProjectID: view.ProjectID,
+		StateID:   view.StateID,
+		CycleID:   view.CycleID,
+	}
+}
+
+// applySavedView makes the view's filter, sort, and grouping current without
+// refreshing issues.
+func (a *App) applySavedView(view config.SavedView) {
+	a.selectedNavigation = viewNavigationNode(view)
+	a.searchQuery = view.Query
+	switch SortField(view.Sort) {
+	case SortByUpdatedAt, SortByCreatedAt, SortByPriority:
+		a.sortField = SortField(view.Sort)
+	}
+	if view.Layout != "" {
+		a.config.IssuesLayout = view.Layout
+	}
+}
+
+// currentViewState captures the current filter, sort, and grouping as a view.
+func (a *App) currentViewState(name string) config.SavedView {
+	view := config.SavedView{
+		Name:   name,
+		Query:  a.searchQuery,
+		Sort:   string(a.sortField),
+		Layout: a.config.IssuesLayout,
+	}
+	if nav := a.selectedNavigation; nav != nil {
+		view.TeamID = nav.TeamID
+		switch {
+		case nav.IsView:
+			view.ProjectID = nav.ProjectID
+			view.StateID = nav.StateID
+			view.CycleID = nav.CycleID
+		case nav.IsProject:
+			view.ProjectID = nav.ID
+		case nav.IsStatus:
+			view.StateID = nav.StateID
+		case nav.IsCycle:
+			view.CycleID = nav.CycleID
+		}
+	}
+	return view
+}
+
+// renderViewsSection shows the saved views below "All Issues" in the
+// navigation tree. The section is hidden when there are no views.
+func (a *App) renderViewsSection() {
+	if a.viewsNode == nil {
+		return // Navigation has not loaded yet
+	}
+
+	a.viewsNode.ClearChildren()
+	for _, view := range a.savedViews {
+		label := view.Name
+		if view.Default {
+			label += " ★"
+		}
+		a.viewsNode.AddChild(tview.NewTreeNode(label).
+			SetColor(a.theme.Foreground).
+			SetReference(viewNavigationNode(view)))
+	}
+
+	root := a.navigationTree.GetRoot()
+	children := make([]*tview.TreeNode, 0, len(root.GetChildren())+1)
+	for _, child := range root.GetChildren() {
+		if child == a.viewsNode {
+			continue
+		}
+		children = append(children, child)
+		if navNode, ok := child.GetReference().(*NavigationNode); ok && navNode.ID == "all" && len(a.savedViews) > 0 {
+			children = append(children, a.viewsNode)
+		}
+	}
+	root.SetChildren(children)
+}
+
+// findViewTreeNode returns the tree node of the view called name, or nil.
+func (a *App) findViewTreeNode(name string) *tview.TreeNode {
+	if a.viewsNode == nil {
+		return nil
+	}
+	for _, child := range a.viewsNode.GetChildren() {
+		if navNode, ok := child.GetReference().(*NavigationNode); ok && navNode.ViewName == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// saveSavedViews writes views to the views file and updates the navigation tree.
+func (a *App) saveSavedViews(views []config.SavedView) error {
+	viewsPath, err := config.ViewsFilePath()
+	if err != nil {
+		return err
+	}
+	if err := config.SaveSavedViews(viewsPath, views); err != nil {
+		return err
+	}
+	a.savedViews = views
+	a.renderViewsSection()
+	return nil
+}
+
+// showSaveViewPrompt asks for a name and saves the current filter as a view.
+func (a *App) showSaveViewPrompt() {
+	name := ""
+	if a.selectedNavigation != nil && a.selectedNavigation.IsView {
+		name = a.selectedNavigation.ViewName
+	}
+	a.textInputModal.Show("Save View", "Name: ", name, func(name string) {
+		views, err := config.PutSavedView(a.savedViews, a.currentViewState(name))
+		if err == nil {
+			err = a.saveSavedViews(views)
+		}
+		if err != nil {
+			logger.ErrorWithErr(err, "tui.commands: failed to save view")
+			a.updateStatusBarWithError(err)
+			return
+		}
+		logger.Info("tui.commands: saved view name=%s", name)
+
+		view, _ := config.FindSavedView(views, name)
+		a.selectedNavigation = viewNavigationNode(view)
+		if node := a.findViewTreeNode(view.Name); node != nil {
+			a.navigationTree.SetCurrentNode(node)
+		}
+		a.updateStatusBar()
+	})
+}
+
+// showViewPicker shows a picker of saved views and calls onSelect with the
+// chosen view.
+func (a *App) showViewPicker(title string, onSelect func(view config.SavedView)) {
+	if len(a.savedViews) == 0 {
+		a.updateStatusBarWithError(fmt.Errorf("no saved views"))
+		return
+	}
+
+	items := make([]PickerItem, 0, len(a.savedViews))
+	for _, view := range a.savedViews {
+		items = append(items, PickerItem{ID: view.Name, Label: view.Name})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(title, items, func(item PickerItem) {
+		if view, ok := config.FindSavedView(a.savedViews, item.ID); ok {
+			onSelect(view)
+		}
+	})
+}
+
+// showRenameViewPicker renames a saved view.
+func (a *App) showRenameViewPicker() {
+	a.showViewPicker("Rename View", func(view config.SavedView) {
+		a.textInputModal.Show("Rename View", "Name: ", view.Name, func(name string) {
+			views, err := config.RenameSavedView(a.savedViews, view.Name, name)
+			if err == nil {
+				err = a.saveSavedViews(views)
+			}
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.commands: failed to rename view")
+				a.updateStatusBarWithError(err)
+				return
+			}
+			logger.Info("tui.commands: renamed view from=%s to=%s", view.Name, name)
+
+			if nav := a.selectedNavigation; nav != nil && nav.IsView && nav.ViewName == view.Name {
+				renamed, _ := config.FindSavedView(views, name)
+				a.selectedNavigation = viewNavigationNode(renamed)
+			}
+			a.updateStatusBar()
+		})
+	})
+}
+
+// showDeleteViewPicker deletes a saved view.
+func (a *App) showDeleteViewPicker() {
+	a.showViewPicker("Delete View", func(view config.SavedView) {
+		views, err := config.DeleteSavedView(a.savedViews, view.Name)
+		if err == nil {
+			err = a.saveSavedViews(views)
+		}
+		if err != nil {
+			logger.ErrorWithErr(err, "tui.commands: failed to delete view")
+			a.updateStatusBarWithError(err)
+			return
+		}
+		logger.Info("tui.commands: deleted view name=%s", view.Name)
+		a.updateStatusBar()
+	})
+}
+
+// showDefaultViewPicker sets the view selected at startup.
+func (a *App) showDefaultViewPicker() {
+	if len(a.savedViews) == 0 {
+		a.updateStatusBarWithError(fmt.Errorf("no saved views"))
+		return
+	}
+
+	items := []PickerItem{{ID: noDefaultViewID, Label: "No default (All Issues)"}}
+	for _, view := range a.savedViews {
+		label := view.Name
+		if view.Default {
+			label += " (current)"
+		}
+		items = append(items, PickerItem{ID: view.Name, Label: label})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Startup View", items, func(item PickerItem) {
+		views, err := config.SetDefaultSavedView(a.savedViews, item.ID)
+		if err == nil {
+			err = a.saveSavedViews(views)
+		}
+		if err != nil {
+			logger.ErrorWithErr(err, "tui.commands: failed to set default view")
+			a.updateStatusBarWithError(err)
+			return
+		}
+		logger.Info("tui.commands: set default view name=%s", item.ID)
+	})
+}
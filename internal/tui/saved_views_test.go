@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/cache"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+func TestSetSavedViews_AppliesDefaultView(t *testing.T) {
+	cfg := config.Config{
+		PageSize:     1,
+		CacheTTL:     time.Minute,
+		IssuesLayout: config.IssuesLayoutTable,
+	}
+	app := NewApp(&linearapi.Client{}, cfg, nil)
+	ui := serializeUpdates(app)
+	// The board layout loads the team's workflow states
+	app.cache.Restore(cache.TeamMetadata{
+		SavedAt: time.Now(),
+		States:  map[string][]linearapi.WorkflowState{"team-1": {{ID: "state-1", Name: "Todo"}}},
+	})
+
+	called := make(chan linearapi.FetchIssuesParams, 1)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		select {
+		case called <- params:
+		default:
+		}
+		return linearapi.IssuePage{Issues: []linearapi.Issue{}, HasNext: false}, nil
+	}
+
+	app.SetSavedViews([]config.SavedView{
+		{Name: "Other", Query: "label:docs"},
+		{
+			Name:    "Eng bugs",
+			Query:   "label:bug",
+			Sort:    string(SortByPriority),
+			Layout:  config.IssuesLayoutBoard,
+			TeamID:  "team-1",
+			StateID: "state-1",
+			Default: true,
+		},
+	})
+
+	if app.selectedNavigation == nil || !app.selectedNavigation.IsView || app.selectedNavigation.ViewName != "Eng bugs" {
+		t.Fatalf("selectedNavigation = %+v, want the default view", app.selectedNavigation)
+	}
+	if app.sortField != SortByPriority || app.config.IssuesLayout != config.IssuesLayoutBoard {
+		t.Errorf("sortField = %q, layout = %q; want priority and board", app.sortField, app.config.IssuesLayout)
+	}
+
+	app.refreshIssues()
+
+	select {
+	case params := <-called:
+		if params.TeamID != "team-1" || params.StateID != "state-1" {
+			t.Errorf("TeamID = %q, StateID = %q; want the view's scope", params.TeamID, params.StateID)
+		}
+		if params.OrderBy != string(SortByPriority) || len(params.Query.Terms) != 1 {
+			t.Errorf("OrderBy = %q, %d query terms; want priority and 1 term", params.OrderBy, len(params.Query.Terms))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for fetchIssuesPage")
+	}
+
+	// Let the board finish loading its columns before the test ends
+	waitForCondition(t, time.Second, func() bool {
+		ui.Lock()
+		defer ui.Unlock()
+		return len(app.workflowStates) > 0
+	})
+}
+
+func TestCurrentViewState(t *testing.T) {
+	cfg := config.Config{IssuesLayout: config.IssuesLayoutTable}
+	app := NewApp(&linearapi.Client{}, cfg, nil)
+	app.searchQuery = "assignee:me"
+	app.sortField = SortByCreatedAt
+	app.selectedNavigation = &NavigationNode{ID: "project-1", Text: "Infra", TeamID: "team-1", IsProject: true}
+
+	want := config.SavedView{
+		Name:      "Infra mine",
+		Query:     "assignee:me",
+		Sort:      string(SortByCreatedAt),
+		Layout:    config.IssuesLayoutTable,
+		TeamID:    "team-1",
+		ProjectID: "project-1",
+	}
+	if got := app.currentViewState("Infra mine"); got != want {
+		t.Errorf("currentViewState() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// maxQuerySuggestions limits the autocomplete list in the search palette.
+const maxQuerySuggestions = 8
+
+// queryDateSuggestions are offered for updated: and created: values.
+var queryDateSuggestions = []string{"1d", "7d", "2w", "30d"}
+
+// queryTokenAt describes the token being typed at the end of a search query.
+type queryTokenAt struct {
+	Start   int    // Byte offset of the token in the query
+	Prefix  string // "-" for negated terms
+	Field   string // Field name, or "" while the field is still being typed
+	Op      string // Comparison operator typed before the value
+	Partial string // Text typed so far for the field or value, without quotes
+}
+
+// lastQueryToken returns the token at the end of query. A trailing space
+// starts a new, empty token.
+func lastQueryToken(query string) queryTokenAt {
+	start := 0
+	inQuote := false
+	for i, r := range query {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+		case r == ' ' && !inQuote:
+			start = i + 1
+		}
+	}
+
+	token := queryTokenAt{Start: start}
+	text := query[start:]
+	if strings.HasPrefix(text, "-") {
+		token.Prefix = "-"
+		text = text[1:]
+	}
+	field, value, ok := strings.Cut(text, ":")
+	if !ok {
+		token.Partial = strings.TrimPrefix(text, `"`)
+		return token
+	}
+	token.Field = strings.ToLower(field)
+	for _, op := range []string{linearapi.QueryOpGte, linearapi.QueryOpLte, linearapi.QueryOpGt, linearapi.QueryOpLt} {
+		if strings.HasPrefix(value, op) {
+			token.Op = op
+			value = value[len(op):]
+			break
+		}
+	}
+	token.Partial = strings.Trim(value, `"`)
+	return token
+}
+
+// querySuggestions returns completions for the token at the end of query:
+// field names while a field is typed, then values for that field.
+func querySuggestions(query string, values func(field string) []string) []string {
+	token := lastQueryToken(query)
+	partial := strings.ToLower(token.Partial)
+
+	var suggestions []string
+	if token.Field == "" {
+		if strings.Contains(query[token.Start:], `"`) {
+			return nil // Quoted free text
+		}
+		for _, field := range linearapi.QueryFields {
+			if strings.HasPrefix(field, partial) {
+				suggestions = append(suggestions, token.Prefix+field+":")
+			}
+		}
+		return suggestions
+	}
+
+	seen := make(map[string]bool)
+	for _, value := range values(token.Field) {
+		lower := strings.ToLower(value)
+		if value == "" || seen[lower] || !strings.HasPrefix(lower, partial) || lower == partial {
+			continue
+		}
+		seen[lower] = true
+		suggestions = append(suggestions, token.Prefix+token.Field+":"+token.Op+quoteQueryValue(value))
+		if len(suggestions) == maxQuerySuggestions {
+			break
+		}
+	}
+	return suggestions
+}
+
+// applyQuerySuggestion replaces the token at the end of query with suggestion.
+// Completed values are followed by a space so the next term can be typed.
+func applyQuerySuggestion(query, suggestion string) string {
+	completed := query[:lastQueryToken(query).Start] + suggestion
+	if !strings.HasSuffix(suggestion, ":") {
+		completed += " "
+	}
+	return completed
+}
+
+// quoteQueryValue quotes values that contain spaces.
+func quoteQueryValue(value string) string {
+	if strings.ContainsAny(value, " \t") {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+// queryFieldValues returns known values for a query field from cached team
+// metadata. Values of the selected team are used when one is selected.
+func (a *App) queryFieldValues(field string) []string {
+	metadata := a.GetCache().Export()
+	teamID := a.GetSelectedTeamID()
+	var values []string
+
+	switch field {
+	case linearapi.QueryFieldAssignee:
+		values = append(values, "me", "none")
+		for _, users := range teamValues(metadata.Users, teamID) {
+			for _, user := range users {
+				values = append(values, user.Name)
+			}
+		}
+	case linearapi.QueryFieldState:
+		for _, states := range teamValues(metadata.States, teamID) {
+			for _, state := range states {
+				values = append(values, state.Name)
+			}
+		}
+	case linearapi.QueryFieldLabel:
+		for _, labels := range teamValues(metadata.Labels, teamID) {
+			for _, label := range labels {
+				values = append(values, label.Name)
+			}
+		}
+	case linearapi.QueryFieldProject:
+		values = append(values, "none")
+		for _, projects := range teamValues(metadata.Projects, teamID) {
+			for _, project := range projects {
+				values = append(values, project.Name)
+			}
+		}
+	case linearapi.QueryFieldTeam:
+		for _, team := range metadata.Teams {
+			values = append(values, team.Key)
+		}
+	case linearapi.QueryFieldPriority:
+		values = append(values, "urgent", "high", "normal", "low", "none")
+	case linearapi.QueryFieldUpdated, linearapi.QueryFieldCreated:
+		values = append(values, queryDateSuggestions...)
+	}
+	return values
+}
+
+// teamValues returns the cached values for teamID, or for every team (in a
+// stable order) when no team is selected.
+func teamValues[T any](byTeam map[string][]T, teamID string) [][]T {
+	if teamID != "" {
+		return [][]T{byTeam[teamID]}
+	}
+	teamIDs := make([]string, 0, len(byTeam))
+	for id := range byTeam {
+		teamIDs = append(teamIDs, id)
+	}
+	sort.Strings(teamIDs)
+	values := make([][]T, 0, len(teamIDs))
+	for _, id := range teamIDs {
+		values = append(values, byTeam[id])
+	}
+	return values
+}
+
+// updateSearchSuggestions validates the search palette query and refreshes
+// the autocomplete suggestions for it.
+func (a *App) updateSearchSuggestions() {
+	query := a.paletteCtrl.Query()
+	_, a.searchQueryErr = linearapi.ParseIssueQuery(query, time.Now())
+	a.paletteCtrl.SetSuggestions(querySuggestions(query, a.queryFieldValues))
+}
+
+// acceptSearchSuggestion completes the search query with the selected suggestion.
+func (a *App) acceptSearchSuggestion() {
+	suggestion, ok := a.paletteCtrl.SelectedSuggestion()
+	if !ok {
+		return
+	}
+	query := applyQuerySuggestion(a.paletteCtrl.Query(), suggestion)
+	a.paletteCtrl.SetQuery(query)
+	a.paletteInput.SetText(query)
+	a.updateSearchSuggestions()
+	a.updatePaletteList()
+}
+
+// searchParams parses the search query into free text and field terms.
+func searchParams(query string, now time.Time) (string, linearapi.IssueQuery) {
+	parsed, err := linearapi.ParseIssueQuery(query, now)
+	if err != nil {
+		// The palette rejects invalid queries, so fall back to plain text search
+		return query, linearapi.IssueQuery{}
+	}
+	return parsed.Text, parsed
+}
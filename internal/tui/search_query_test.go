@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQuerySuggestions(t *testing.T) {
+	values := func(field string) []string {
+		switch field {
+		case "state":
+			return []string{"Todo", "In Progress", "In Review", "Done"}
+		case "label":
+			return []string{"bug", "Bug", "backend"}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "field names", query: "crash a", want: []string{"assignee:"}},
+		{name: "negated field names", query: "-la", want: []string{"-label:"}},
+		{name: "values are quoted", query: "state:in", want: []string{`state:"In Progress"`, `state:"In Review"`}},
+		{name: "partially quoted value", query: `state:"In R`, want: []string{`state:"In Review"`}},
+		{name: "duplicate values are merged", query: "label:b", want: []string{"label:bug", "label:backend"}},
+		{name: "field without known values", query: "team:", want: nil},
+		{name: "quoted free text", query: `"crash`, want: nil},
+		{name: "completed value", query: "label:bug", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := querySuggestions(tt.query, values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("querySuggestions(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuerySuggestionsWithOperator(t *testing.T) {
+	values := func(string) []string { return queryDateSuggestions }
+	got := querySuggestions("updated:<7", values)
+	if !reflect.DeepEqual(got, []string{"updated:<7d"}) {
+		t.Errorf("querySuggestions() = %q, want [updated:<7d]", got)
+	}
+}
+
+func TestApplyQuerySuggestion(t *testing.T) {
+	if got := applyQuerySuggestion("crash sta", "state:"); got != "crash state:" {
+		t.Errorf("applyQuerySuggestion(field) = %q", got)
+	}
+	if got := applyQuerySuggestion(`crash state:"In`, `state:"In Progress"`); got != `crash state:"In Progress" ` {
+		t.Errorf("applyQuerySuggestion(value) = %q", got)
+	}
+}
+
+func TestSearchParams(t *testing.T) {
+	text, query := searchParams("label:bug login crash", time.Now())
+	if text != "login crash" || len(query.Terms) != 1 {
+		t.Errorf("searchParams() = %q, %d terms; want free text and 1 term", text, len(query.Terms))
+	}
+
+	text, query = searchParams("stauts:done", time.Now())
+	if text != "stauts:done" || len(query.Terms) != 0 {
+		t.Errorf("searchParams(invalid) = %q, %d terms; want plain text fallback", text, len(query.Terms))
+	}
+}
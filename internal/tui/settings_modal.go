@@ -170,6 +170,22 @@ func claudeModelOptions() []agentModelOption {
 	}
 }
 
+// codexModelOptions returns Codex model options supported by `codex --model`.
+func codexModelOptions() []agentModelOption {
+	return []agentModelOption{
+		{id: "gpt-5-codex", label: "GPT-5 Codex"},
+		{id: "gpt-5", label: "GPT-5"},
+	}
+}
+
+// geminiModelOptions returns Gemini model options supported by `gemini --model`.
+func geminiModelOptions() []agentModelOption {
+	return []agentModelOption{
+		{id: "gemini-2.5-pro", label: "Gemini 2.5 Pro"},
+		{id: "gemini-2.5-flash", label: "Gemini 2.5 Flash"},
+	}
+}
+
 // defaultAgentModelOptions returns the default-only model dropdown values.
 func defaultAgentModelOptions() ([]string, []string) {
 	return []string{defaultAgentModelLabel}, []string{""}
@@ -202,6 +218,10 @@ func agentModelOptionsForProvider(provider string) ([]string, []string) {
 		options = cursorModelOptions()
 	case "claude":
 		options = claudeModelOptions()
+	case "codex":
+		options = codexModelOptions()
+	case "gemini":
+		options = geminiModelOptions()
 	default:
 		return labels, values
 	}
@@ -223,6 +243,7 @@ type SettingsModal struct {
 	timeoutField         *tview.InputField
 	pageSizeField        *tview.InputField
 	cacheTTLField        *tview.InputField
+	pollIntervalField    *tview.InputField
 	logFileField         *tview.InputField
 	logLevelField        *tview.DropDown
 	logLevelOptions      []string
@@ -244,7 +265,7 @@ type SettingsModal struct {
 
 // NewSettingsModal creates a new settings modal.
 func NewSettingsModal(app *App) *SettingsModal {
-	availableProviders := agents.AvailableProviderKeys(exec.LookPath)
+	availableProviders := agents.AvailableProviderKeys(app.config.AgentCommands, exec.LookPath)
 	selectedProvider := selectAvailableProvider(config.DefaultAgentProvider, availableProviders)
 	modelLabels, modelValues := agentModelOptionsForProvider(selectedProvider)
 	sm := &SettingsModal{
@@ -297,6 +318,12 @@ func NewSettingsModal(app *App) *SettingsModal {
 		SetFieldWidth(20)
 	sm.form.AddFormItem(sm.cacheTTLField)
 
+	sm.pollIntervalField = tview.NewInputField().
+		SetLabel("Poll interval").
+		SetPlaceholder("0 disables background refresh").
+		SetFieldWidth(20)
+	sm.form.AddFormItem(sm.pollIntervalField)
+
 	sm.logFileField = tview.NewInputField().
 		SetLabel("Log file").
 		SetFieldWidth(60)
@@ -421,7 +448,7 @@ func NewSettingsModal(app *App) *SettingsModal {
 func (sm *SettingsModal) Show() {
 	logger.Debug("tui.settings: showing settings modal")
 	settings := config.SettingsFromConfig(sm.app.config)
-	availableProviders := agents.AvailableProviderKeys(exec.LookPath)
+	availableProviders := agents.AvailableProviderKeys(sm.app.config.AgentCommands, exec.LookPath)
 	sm.setAgentProviderOptions(availableProviders)
 	selectedProvider := selectAvailableProvider(settings.AgentProvider, availableProviders)
 
@@ -429,6 +456,7 @@ func (sm *SettingsModal) Show() {
 	sm.timeoutField.SetText(settings.Timeout)
 	sm.pageSizeField.SetText(strconv.Itoa(settings.PageSize))
 	sm.cacheTTLField.SetText(settings.CacheTTL)
+	sm.pollIntervalField.SetText(settings.PollInterval)
 	sm.logFileField.SetText(settings.LogFile)
 	sm.setLogLevelSelection(settings.LogLevel)
 	sm.setThemeSelection(settings.Theme)
@@ -592,6 +620,10 @@ func (sm *SettingsModal) saveSettings() {
 
 	settings := config.Settings{
 		APIEndpoint:    strings.TrimSpace(sm.endpointField.GetText()),
+		APIKeyCommand:  sm.app.config.APIKeyCommand,
+		APIKeyFile:     sm.app.config.APIKeyFile,
+		AuthMethod:     sm.app.config.AuthMethod,
+		OAuth:          sm.app.config.OAuth,
 		Timeout:        strings.TrimSpace(sm.timeoutField.GetText()),
 		PageSize:       pageSize,
 		CacheTTL:       strings.TrimSpace(sm.cacheTTLField.GetText()),
@@ -599,18 +631,29 @@ func (sm *SettingsModal) saveSettings() {
 		LogLevel:       logLevel,
 		Theme:          theme,
 		Density:        density,
+		IssuesLayout:   sm.app.config.IssuesLayout,
 		AgentProvider:  agentProvider,
 		AgentSandbox:   agentSandbox,
 		AgentModel:     agentModel,
 		AgentWorkspace: strings.TrimSpace(sm.agentWorkspaceField.GetText()),
+		AgentProviders: sm.app.config.AgentCommands,
+		WebhookAddr:    sm.app.config.WebhookAddr,
+		PollInterval:   strings.TrimSpace(sm.pollIntervalField.GetText()),
+		Profile:        sm.app.config.DefaultProfile,
+		Profiles:       sm.app.config.Profiles,
 	}
 
-	newCfg, err := config.ConfigFromSettings(sm.app.config.LinearAPIKey, settings)
+	newCfg, err := config.ConfigFromSettingsForProfile(sm.app.config.LinearAPIKey, settings, sm.app.config.Profile)
 	if err != nil {
 		logger.ErrorWithErr(err, "tui.settings: failed to parse settings")
 		sm.app.updateStatusBarWithError(err)
 		return
 	}
+	if sm.app.config.TokenSource != nil {
+		// Keep the logged-in OAuth session instead of the API key source
+		newCfg.APIKeySource = config.KeySource{}
+		newCfg.TokenSource = sm.app.config.TokenSource
+	}
 
 	settingsPath, err := config.ConfigFilePath()
 	if err != nil {
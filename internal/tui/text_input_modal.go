@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// TextInputModal manages a single-line text prompt overlay.
+type TextInputModal struct {
+	app          *App
+	modal        *tview.Flex
+	modalContent *tview.Flex
+	form         *tview.Form
+	titleView    *tview.TextView
+	inputField   *tview.InputField
+	onSubmit     func(text string)
+}
+
+// NewTextInputModal creates a new text input modal.
+func NewTextInputModal(app *App) *TextInputModal {
+	tim := &TextInputModal{
+		app: app,
+	}
+
+	// Create form
+	tim.form = tview.NewForm()
+	tim.form.SetBackgroundColor(app.theme.HeaderBg)
+	tim.form.SetFieldBackgroundColor(app.theme.InputBg)
+	tim.form.SetFieldTextColor(app.theme.Foreground)
+	tim.form.SetButtonBackgroundColor(app.theme.Accent)
+	tim.form.SetButtonTextColor(app.theme.SelectionText)
+	tim.form.SetLabelColor(app.theme.Foreground)
+
+	// Add input field
+	tim.inputField = tview.NewInputField()
+	tim.inputField.SetFieldWidth(40)
+	tim.form.AddFormItem(tim.inputField)
+
+	// Add buttons
+	tim.form.AddButton("Save", func() {
+		tim.submit()
+	})
+	tim.form.AddButton("Cancel", func() {
+		tim.Hide()
+	})
+
+	// Create title
+	tim.titleView = tview.NewTextView()
+	tim.titleView.SetTextColor(app.theme.Accent)
+	tim.titleView.SetBackgroundColor(app.theme.HeaderBg)
+
+	// Build modal content
+	tim.modalContent = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(tim.titleView, 1, 0, false).
+		AddItem(tim.form, 0, 1, true)
+	tim.modalContent.Box = tview.NewBox().SetBackgroundColor(app.theme.HeaderBg)
+	tim.modalContent.SetBackgroundColor(app.theme.HeaderBg).
+		SetBorder(true).
+		SetBorderColor(app.theme.Accent).
+		SetTitleColor(app.theme.Foreground)
+	padding := app.density.ModalPadding
+	tim.modalContent.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
+
+	// Center the modal on screen
+	tim.modal = tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().
+			SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(tim.modalContent, 8, 0, true).
+			AddItem(nil, 0, 1, false), 60, 0, true).
+		AddItem(nil, 0, 1, false)
+	tim.modal.SetBackgroundColor(app.theme.Background)
+
+	return tim
+}
+
+// Show displays the text input modal. onSubmit is called with the trimmed
+// text when it is not empty.
+func (tim *TextInputModal) Show(title, label, text string, onSubmit func(text string)) {
+	tim.onSubmit = onSubmit
+
+	tim.modalContent.SetTitle(" " + title + " ")
+	tim.titleView.SetText(title)
+	tim.inputField.SetLabel(label)
+	tim.inputField.SetText(text)
+
+	tim.app.pages.AddPage("text_input", tim.modal, true, true)
+	tim.app.pages.SendToFront("text_input")
+	tim.app.app.SetFocus(tim.form)
+}
+
+// Hide hides the text input modal.
+func (tim *TextInputModal) Hide() {
+	tim.app.pages.RemovePage("text_input")
+	tim.app.updateFocus()
+}
+
+// submit hides the modal and passes the entered text to the callback.
+func (tim *TextInputModal) submit() {
+	text := strings.TrimSpace(tim.inputField.GetText())
+	tim.Hide()
+	if tim.onSubmit != nil && text != "" {
+		tim.onSubmit(text)
+	}
+}
+
+// HandleKey handles keyboard input for the text input modal.
+func (tim *TextInputModal) HandleKey(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		tim.Hide()
+		return nil
+	case tcell.KeyEnter:
+		// Enter in the input field submits; on buttons it activates them
+		if tim.inputField.HasFocus() {
+			tim.submit()
+			return nil
+		}
+	}
+	return event
+}
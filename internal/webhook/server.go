@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Server is a running webhook receiver.
+type Server struct {
+	server   *http.Server
+	listener net.Listener
+}
+
+// Listen starts a webhook receiver on addr (e.g. "127.0.0.1:8787") that
+// accepts deliveries signed with secret.
+func Listen(addr, secret string, onEvent func(Event)) (*Server, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("webhook secret is empty")
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen for webhooks on %s: %w", addr, err)
+	}
+
+	s := &Server{
+		server: &http.Server{
+			Handler:           NewHandler(secret, onEvent),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		listener: listener,
+	}
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.ErrorWithErr(err, "webhook.server: receiver stopped addr=%s", addr)
+		}
+	}()
+
+	logger.Info("webhook.server: listening addr=%s", listener.Addr())
+	return s, nil
+}
+
+// Addr returns the address the receiver listens on.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops the receiver.
+func (s *Server) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Send delivers a recorded webhook payload to a receiver, as Linear would. The
+// payload's webhookTimestamp is set to the current time before signing so that
+// old recordings are not rejected as stale. It is meant for testing a local
+// receiver.
+func Send(ctx context.Context, url, secret string, body []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return fmt.Errorf("parse payload: %w", err)
+	}
+	fields["webhookTimestamp"] = json.RawMessage(fmt.Sprintf("%d", time.Now().UnixMilli()))
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, body))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("send webhook: receiver returned %s", resp.Status)
+	}
+	return nil
+}
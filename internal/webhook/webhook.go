@@ -0,0 +1,315 @@
+// Package webhook receives Linear webhook deliveries, verifies their signature,
+// and converts issue, comment, and label payloads into events for the UI.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+const (
+	// SignatureHeader carries the hex HMAC-SHA256 of the request body.
+	SignatureHeader = "Linear-Signature"
+
+	// MaxTimestampAge is how old a delivery's webhookTimestamp may be before it
+	// is rejected as a possible replay. Deliveries without one are rejected.
+	MaxTimestampAge = time.Minute
+
+	// maxBodySize limits the size of accepted payloads.
+	maxBodySize = 1 << 20
+)
+
+// Webhook actions.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionRemove = "remove"
+)
+
+// Webhook payload types handled by the receiver.
+const (
+	TypeIssue      = "Issue"
+	TypeComment    = "Comment"
+	TypeIssueLabel = "IssueLabel"
+)
+
+// Event is a verified webhook delivery. Exactly one of Issue, Comment, and
+// Label is set, matching Type.
+type Event struct {
+	Action  string // create, update, or remove
+	Type    string // Issue, Comment, or IssueLabel
+	Issue   *linearapi.Issue
+	Comment *linearapi.Comment
+	Label   *linearapi.IssueLabel
+}
+
+// payload is the envelope of a Linear webhook delivery.
+type payload struct {
+	Action           string          `json:"action"`
+	Type             string          `json:"type"`
+	Data             json.RawMessage `json:"data"`
+	WebhookTimestamp int64           `json:"webhookTimestamp"` // Unix milliseconds
+}
+
+// ref is a nested object reference in payload data.
+type ref struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// issueData is the data of an Issue payload.
+type issueData struct {
+	ID          string  `json:"id"`
+	Identifier  string  `json:"identifier"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	Priority    float64 `json:"priority"`
+	StateID     string  `json:"stateId"`
+	State       *ref    `json:"state"`
+	AssigneeID  *string `json:"assigneeId"`
+	Assignee    *ref    `json:"assignee"`
+	TeamID      string  `json:"teamId"`
+	ProjectID   *string `json:"projectId"`
+	CycleID     *string `json:"cycleId"`
+	ParentID    *string `json:"parentId"`
+	Labels      []struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"labels"`
+	URL        string  `json:"url"`
+	CreatedAt  string  `json:"createdAt"`
+	UpdatedAt  string  `json:"updatedAt"`
+	ArchivedAt *string `json:"archivedAt"`
+}
+
+// commentData is the data of a Comment payload.
+type commentData struct {
+	ID        string  `json:"id"`
+	Body      string  `json:"body"`
+	IssueID   string  `json:"issueId"`
+	ParentID  *string `json:"parentId"`
+	UserID    string  `json:"userId"`
+	User      *ref    `json:"user"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+}
+
+// labelData is the data of an IssueLabel payload.
+type labelData struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Sign returns the Linear-Signature value for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the valid signature of body.
+func Verify(secret string, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// Handler is an http.Handler that verifies webhook deliveries and passes
+// supported events to a callback.
+type Handler struct {
+	secret  string
+	onEvent func(Event)
+	now     func() time.Time
+}
+
+// NewHandler creates a handler that verifies deliveries with secret and calls
+// onEvent for each issue, comment, and label event. onEvent is called on the
+// request goroutine.
+func NewHandler(secret string, onEvent func(Event)) *Handler {
+	return &Handler{
+		secret:  secret,
+		onEvent: onEvent,
+		now:     time.Now,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil || len(body) > maxBodySize {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+	if !Verify(h.secret, body, r.Header.Get(SignatureHeader)) {
+		logger.Warning("webhook.handler: rejected delivery with invalid signature remote=%s", r.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	// Without a timestamp a captured delivery could be replayed indefinitely
+	if p.WebhookTimestamp == 0 {
+		logger.Warning("webhook.handler: rejected delivery without timestamp type=%s remote=%s", p.Type, r.RemoteAddr)
+		http.Error(w, "missing webhookTimestamp", http.StatusBadRequest)
+		return
+	}
+	age := h.now().Sub(time.UnixMilli(p.WebhookTimestamp))
+	if age > MaxTimestampAge || age < -MaxTimestampAge {
+		logger.Warning("webhook.handler: rejected stale delivery type=%s age=%s", p.Type, age)
+		http.Error(w, "stale delivery", http.StatusUnauthorized)
+		return
+	}
+
+	event, ok, err := parseEvent(p)
+	if err != nil {
+		logger.Warning("webhook.handler: failed to parse delivery type=%s action=%s error=%v", p.Type, p.Action, err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if !ok {
+		logger.Debug("webhook.handler: ignoring delivery type=%s action=%s", p.Type, p.Action)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	logger.Debug("webhook.handler: received delivery type=%s action=%s", event.Type, event.Action)
+	if h.onEvent != nil {
+		h.onEvent(event)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// parseEvent converts a payload into an Event. It returns false for payload
+// types and actions the receiver does not handle.
+func parseEvent(p payload) (Event, bool, error) {
+	switch p.Action {
+	case ActionCreate, ActionUpdate, ActionRemove:
+	default:
+		return Event{}, false, nil
+	}
+
+	event := Event{Action: p.Action, Type: p.Type}
+	switch p.Type {
+	case TypeIssue:
+		var data issueData
+		if err := json.Unmarshal(p.Data, &data); err != nil {
+			return Event{}, false, fmt.Errorf("parse issue data: %w", err)
+		}
+		issue := data.issue()
+		event.Issue = &issue
+	case TypeComment:
+		var data commentData
+		if err := json.Unmarshal(p.Data, &data); err != nil {
+			return Event{}, false, fmt.Errorf("parse comment data: %w", err)
+		}
+		comment := data.comment()
+		event.Comment = &comment
+	case TypeIssueLabel:
+		var data labelData
+		if err := json.Unmarshal(p.Data, &data); err != nil {
+			return Event{}, false, fmt.Errorf("parse label data: %w", err)
+		}
+		event.Label = &linearapi.IssueLabel{ID: data.ID, Name: data.Name, Color: data.Color}
+	default:
+		return Event{}, false, nil
+	}
+	return event, true, nil
+}
+
+// issue converts issue data to an Issue. Only the cycle and parent IDs are
+// known, so their other fields are empty.
+func (d issueData) issue() linearapi.Issue {
+	issue := linearapi.Issue{
+		ID:         d.ID,
+		Identifier: d.Identifier,
+		Title:      d.Title,
+		Priority:   int(d.Priority),
+		StateID:    d.StateID,
+		TeamID:     d.TeamID,
+		URL:        d.URL,
+		CreatedAt:  parseTime(d.CreatedAt),
+		UpdatedAt:  parseTime(d.UpdatedAt),
+		Archived:   d.ArchivedAt != nil,
+		Labels:     make([]linearapi.IssueLabel, 0, len(d.Labels)),
+	}
+	if d.Description != nil {
+		issue.Description = *d.Description
+	}
+	if d.State != nil {
+		issue.StateID = d.State.ID
+		issue.State = d.State.Name
+	}
+	if d.AssigneeID != nil {
+		issue.AssigneeID = *d.AssigneeID
+	}
+	if d.Assignee != nil {
+		issue.AssigneeID = d.Assignee.ID
+		issue.Assignee = d.Assignee.Name
+	}
+	if d.ProjectID != nil {
+		issue.ProjectID = *d.ProjectID
+	}
+	if d.CycleID != nil && *d.CycleID != "" {
+		issue.Cycle = &linearapi.CycleRef{ID: *d.CycleID}
+	}
+	if d.ParentID != nil && *d.ParentID != "" {
+		issue.Parent = &linearapi.IssueRef{ID: *d.ParentID}
+	}
+	for _, label := range d.Labels {
+		issue.Labels = append(issue.Labels, linearapi.IssueLabel{ID: label.ID, Name: label.Name, Color: label.Color})
+	}
+	return issue
+}
+
+// comment converts comment data to a Comment.
+func (d commentData) comment() linearapi.Comment {
+	comment := linearapi.Comment{
+		ID:        d.ID,
+		Body:      d.Body,
+		IssueID:   d.IssueID,
+		CreatedAt: parseTime(d.CreatedAt),
+		UpdatedAt: parseTime(d.UpdatedAt),
+		Author:    linearapi.User{ID: d.UserID},
+	}
+	if d.ParentID != nil {
+		comment.ParentID = *d.ParentID
+	}
+	if d.User != nil {
+		comment.Author.ID = d.User.ID
+		comment.Author.Name = d.User.Name
+	}
+	return comment
+}
+
+// parseTime parses an RFC3339 timestamp, returning the zero time on error.
+func parseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
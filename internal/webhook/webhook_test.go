@@ -0,0 +1,235 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testSecret = "lin_wh_test_secret"
+
+// readPayload reads a recorded payload from testdata.
+func readPayload(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read payload %s: %v", name, err)
+	}
+	return body
+}
+
+// newTestHandler returns a handler whose clock is fixed at the recorded
+// payloads' delivery time, and a function returning received events.
+func newTestHandler() (*Handler, func() []Event) {
+	var mu sync.Mutex
+	var events []Event
+	handler := NewHandler(testSecret, func(event Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	})
+	handler.now = func() time.Time { return time.UnixMilli(1736942400000) }
+	return handler, func() []Event {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]Event(nil), events...)
+	}
+}
+
+// post sends a signed request for body to handler and returns the status code.
+func post(handler http.Handler, body []byte, signature string) int {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, signature)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"action":"update"}`)
+	signature := Sign(testSecret, body)
+
+	if !Verify(testSecret, body, signature) {
+		t.Error("Verify() = false for a valid signature")
+	}
+	if Verify("other-secret", body, signature) {
+		t.Error("Verify() = true with the wrong secret")
+	}
+	if Verify(testSecret, []byte(`{"action":"remove"}`), signature) {
+		t.Error("Verify() = true for a modified body")
+	}
+	if Verify(testSecret, body, "not-hex") {
+		t.Error("Verify() = true for a malformed signature")
+	}
+}
+
+func TestHandlerIssueUpdate(t *testing.T) {
+	handler, events := newTestHandler()
+	body := readPayload(t, "issue_update.json")
+
+	if code := post(handler, body, Sign(testSecret, body)); code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", code)
+	}
+
+	got := events()
+	if len(got) != 1 {
+		t.Fatalf("received %d events, want 1", len(got))
+	}
+	event := got[0]
+	if event.Type != TypeIssue || event.Action != ActionUpdate || event.Issue == nil {
+		t.Fatalf("event = %+v, want issue update", event)
+	}
+	issue := event.Issue
+	if issue.ID != "issue-12" || issue.Identifier != "ENG-12" || issue.Title != "Login fails on Safari" {
+		t.Errorf("issue = %+v", issue)
+	}
+	if issue.State != "In Progress" || issue.StateID != "state-progress" {
+		t.Errorf("issue state = %q (%s)", issue.State, issue.StateID)
+	}
+	if issue.Assignee != "Alice Smith" || issue.AssigneeID != "user-alice" {
+		t.Errorf("issue assignee = %q (%s)", issue.Assignee, issue.AssigneeID)
+	}
+	if issue.Priority != 2 || issue.TeamID != "team-eng" || issue.ProjectID != "project-web" {
+		t.Errorf("issue priority/team/project = %d/%s/%s", issue.Priority, issue.TeamID, issue.ProjectID)
+	}
+	if issue.Cycle == nil || issue.Cycle.ID != "cycle-7" {
+		t.Errorf("issue cycle = %+v, want cycle-7", issue.Cycle)
+	}
+	if issue.Parent != nil {
+		t.Errorf("issue parent = %+v, want nil", issue.Parent)
+	}
+	if len(issue.Labels) != 1 || issue.Labels[0].Name != "Bug" {
+		t.Errorf("issue labels = %+v", issue.Labels)
+	}
+	if issue.Archived {
+		t.Error("issue archived = true, want false")
+	}
+	if want := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC); !issue.UpdatedAt.Equal(want) {
+		t.Errorf("issue updatedAt = %v, want %v", issue.UpdatedAt, want)
+	}
+}
+
+func TestHandlerRecordedPayloads(t *testing.T) {
+	handler, events := newTestHandler()
+
+	for _, name := range []string{"issue_remove.json", "comment_create.json", "issue_label_update.json"} {
+		body := readPayload(t, name)
+		if code := post(handler, body, Sign(testSecret, body)); code != http.StatusOK {
+			t.Fatalf("%s: status = %d, want 200", name, code)
+		}
+	}
+
+	got := events()
+	if len(got) != 3 {
+		t.Fatalf("received %d events, want 3", len(got))
+	}
+	if got[0].Action != ActionRemove || got[0].Issue == nil || got[0].Issue.ID != "issue-13" || !got[0].Issue.Archived {
+		t.Errorf("remove event = %+v", got[0])
+	}
+	comment := got[1].Comment
+	if got[1].Type != TypeComment || comment == nil {
+		t.Fatalf("comment event = %+v", got[1])
+	}
+	if comment.IssueID != "issue-12" || comment.Author.Name != "Bob Alison" || comment.ParentID != "" {
+		t.Errorf("comment = %+v", comment)
+	}
+	label := got[2].Label
+	if got[2].Type != TypeIssueLabel || label == nil || label.ID != "label-bug" || label.Name != "Defect" {
+		t.Errorf("label event = %+v", got[2])
+	}
+}
+
+func TestHandlerRejectsInvalidDeliveries(t *testing.T) {
+	handler, events := newTestHandler()
+	body := readPayload(t, "issue_update.json")
+
+	if code := post(handler, body, Sign("wrong-secret", body)); code != http.StatusUnauthorized {
+		t.Errorf("wrong secret: status = %d, want 401", code)
+	}
+	if code := post(handler, body, ""); code != http.StatusUnauthorized {
+		t.Errorf("missing signature: status = %d, want 401", code)
+	}
+
+	// A delivery replayed later than MaxTimestampAge is rejected
+	handler.now = func() time.Time { return time.UnixMilli(1736942400000).Add(MaxTimestampAge + time.Second) }
+	if code := post(handler, body, Sign(testSecret, body)); code != http.StatusUnauthorized {
+		t.Errorf("stale delivery: status = %d, want 401", code)
+	}
+
+	// A delivery without a timestamp cannot be checked for replay
+	untimed := []byte(`{"action":"update","type":"Issue","data":{"id":"issue-1"}}`)
+	if code := post(handler, untimed, Sign(testSecret, untimed)); code != http.StatusBadRequest {
+		t.Errorf("missing timestamp: status = %d, want 400", code)
+	}
+
+	invalid := []byte(`{"action":`)
+	if code := post(handler, invalid, Sign(testSecret, invalid)); code != http.StatusBadRequest {
+		t.Errorf("invalid JSON: status = %d, want 400", code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET: status = %d, want 405", rec.Code)
+	}
+
+	if got := events(); len(got) != 0 {
+		t.Errorf("received %d events from invalid deliveries, want 0", len(got))
+	}
+}
+
+func TestHandlerIgnoresUnsupportedTypes(t *testing.T) {
+	handler, events := newTestHandler()
+	body := []byte(`{"action":"create","type":"Reaction","data":{"id":"r-1","emoji":"+1"},"webhookTimestamp":1736942400000}`)
+
+	if code := post(handler, body, Sign(testSecret, body)); code != http.StatusOK {
+		t.Errorf("status = %d, want 200", code)
+	}
+	if got := events(); len(got) != 0 {
+		t.Errorf("received %d events, want 0", len(got))
+	}
+}
+
+func TestListenAndSend(t *testing.T) {
+	received := make(chan Event, 1)
+	server, err := Listen("127.0.0.1:0", testSecret, func(event Event) {
+		received <- event
+	})
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	defer server.Close()
+
+	// Send refreshes the recorded timestamp so the delivery is not stale
+	ctx := context.Background()
+	url := "http://" + server.Addr()
+	if err := Send(ctx, url, testSecret, readPayload(t, "comment_create.json")); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	select {
+	case event := <-received:
+		if event.Comment == nil || event.Comment.ID != "comment-1" {
+			t.Errorf("event = %+v, want comment-1", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+	}
+
+	err = Send(ctx, url, "wrong-secret", readPayload(t, "comment_create.json"))
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("Send() with wrong secret error = %v, want 401", err)
+	}
+
+	if _, err := Listen("127.0.0.1:0", "", nil); err == nil {
+		t.Error("Listen() without secret succeeded, want error")
+	}
+}